@@ -106,9 +106,8 @@ func FilterInIndexedSegments(handler Handler, mt *meta, segments ...*SegmentInfo
 
 	segmentMap := make(map[int64]*SegmentInfo)
 	collectionSegments := make(map[int64][]int64)
-	// TODO(yah01): This can't handle the case of multiple vector fields exist,
-	// modify it if we support multiple vector fields.
-	vecFieldID := make(map[int64]int64)
+	// a segment is regarded as indexed only if all of its vector fields are indexed
+	vecFieldIDs := make(map[int64][]int64)
 	for _, segment := range segments {
 		collectionID := segment.GetCollectionID()
 		segmentMap[segment.GetID()] = segment
@@ -125,16 +124,26 @@ func FilterInIndexedSegments(handler Handler, mt *meta, segments ...*SegmentInfo
 		for _, field := range coll.Schema.GetFields() {
 			if field.GetDataType() == schemapb.DataType_BinaryVector ||
 				field.GetDataType() == schemapb.DataType_FloatVector {
-				vecFieldID[collection] = field.GetFieldID()
-				break
+				vecFieldIDs[collection] = append(vecFieldIDs[collection], field.GetFieldID())
 			}
 		}
 	}
 
 	indexedSegments := make([]*SegmentInfo, 0)
 	for _, segment := range segments {
-		segmentState := mt.GetSegmentIndexStateOnField(segment.GetCollectionID(), segment.GetID(), vecFieldID[segment.GetCollectionID()])
-		if segmentState.state == commonpb.IndexState_Finished {
+		fieldIDs, ok := vecFieldIDs[segment.GetCollectionID()]
+		if !ok {
+			continue
+		}
+		indexed := true
+		for _, fieldID := range fieldIDs {
+			segmentState := mt.GetSegmentIndexStateOnField(segment.GetCollectionID(), segment.GetID(), fieldID)
+			if segmentState.state != commonpb.IndexState_Finished {
+				indexed = false
+				break
+			}
+		}
+		if indexed {
 			indexedSegments = append(indexedSegments, segment)
 		}
 	}
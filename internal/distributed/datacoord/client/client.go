@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -61,6 +62,10 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 	}
 
 	clientParams := &Params.DataCoordGrpcClientCfg
+	tlsConfig, err := tlsutil.InternalClientConfig(clientParams)
+	if err != nil {
+		return nil, err
+	}
 	client := &Client{
 		grpcClient: &grpcclient.ClientBase[datapb.DataCoordClient]{
 			ClientMaxRecvSize:      clientParams.ClientMaxRecvSize.GetAsInt(),
@@ -74,6 +79,7 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 			MaxBackoff:             float32(clientParams.MaxBackoff.GetAsFloat()),
 			BackoffMultiplier:      float32(clientParams.BackoffMultiplier.GetAsFloat()),
 			CompressionEnabled:     clientParams.CompressionEnabled.GetAsBool(),
+			InternalTLSConfig:      tlsConfig,
 		},
 		sess: sess,
 	}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/pkg/tracer"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -143,8 +144,15 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		Timeout: 10 * time.Second, // Wait 10 second for the ping ack before assuming the connection is dead
 	}
 
+	tlsOpts, err := tlsutil.InternalServerOptions(Params)
+	if err != nil {
+		log.Warn("failed to create tls options for grpc server", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := tracer.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize.GetAsInt()),
@@ -154,7 +162,9 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 			logutil.UnaryTraceLoggerInterceptor)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			otelgrpc.StreamServerInterceptor(opts...),
-			logutil.StreamTraceLoggerInterceptor)))
+			logutil.StreamTraceLoggerInterceptor)),
+	}
+	s.grpcServer = grpc.NewServer(append(grpcOpts, tlsOpts...)...)
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
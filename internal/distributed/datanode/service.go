@@ -27,6 +27,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/internal/util/componentutil"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/pkg/tracer"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -127,8 +128,15 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		return
 	}
 
+	tlsOpts, err := tlsutil.InternalServerOptions(Params)
+	if err != nil {
+		log.Warn("failed to create tls options for grpc server", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := tracer.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize.GetAsInt()),
@@ -138,7 +146,9 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 			logutil.UnaryTraceLoggerInterceptor)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			otelgrpc.StreamServerInterceptor(opts...),
-			logutil.StreamTraceLoggerInterceptor)))
+			logutil.StreamTraceLoggerInterceptor)),
+	}
+	s.grpcServer = grpc.NewServer(append(grpcOpts, tlsOpts...)...)
 	datapb.RegisterDataNodeServer(s.grpcServer, s)
 
 	ctx, cancel := context.WithCancel(s.ctx)
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/util/grpcclient"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -48,6 +49,10 @@ func NewClient(ctx context.Context, addr string, encryption bool) (*Client, erro
 		return nil, fmt.Errorf("address is empty")
 	}
 	clientParams := &Params.IndexNodeGrpcClientCfg
+	tlsConfig, err := tlsutil.InternalClientConfig(clientParams)
+	if err != nil {
+		return nil, err
+	}
 	client := &Client{
 		addr: addr,
 		grpcClient: &grpcclient.ClientBase[indexpb.IndexNodeClient]{
@@ -62,6 +67,7 @@ func NewClient(ctx context.Context, addr string, encryption bool) (*Client, erro
 			MaxBackoff:             float32(clientParams.MaxBackoff.GetAsFloat()),
 			BackoffMultiplier:      float32(clientParams.BackoffMultiplier.GetAsFloat()),
 			CompressionEnabled:     clientParams.CompressionEnabled.GetAsBool(),
+			InternalTLSConfig:      tlsConfig,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.IndexNodeRole)
@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/util/grpcclient"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -47,6 +48,10 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 		return nil, fmt.Errorf("address is empty")
 	}
 	clientParams := &Params.ProxyGrpcClientCfg
+	tlsConfig, err := tlsutil.InternalClientConfig(clientParams)
+	if err != nil {
+		return nil, err
+	}
 	client := &Client{
 		addr: addr,
 		grpcClient: &grpcclient.ClientBase[proxypb.ProxyClient]{
@@ -61,6 +66,7 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 			MaxBackoff:             float32(clientParams.MaxBackoff.GetAsFloat()),
 			BackoffMultiplier:      float32(clientParams.BackoffMultiplier.GetAsFloat()),
 			CompressionEnabled:     clientParams.CompressionEnabled.GetAsBool(),
+			InternalTLSConfig:      tlsConfig,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.ProxyRole)
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/parser/sqlparser"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -56,7 +57,9 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.POST("/entities", wrapHandler(h.handleInsert))
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
 	router.POST("/search", wrapHandler(h.handleSearch))
+	router.POST("/hybrid-search", wrapHandler(h.handleHybridSearch))
 	router.POST("/query", wrapHandler(h.handleQuery))
+	router.POST("/sql", wrapHandler(h.handleSQL))
 
 	router.POST("/persist", wrapHandler(h.handleFlush))
 	router.GET("/distance", wrapHandler(h.handleCalcDistance))
@@ -344,25 +347,20 @@ func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	req := milvuspb.SearchRequest{
-		Base:               wrappedReq.Base,
-		DbName:             wrappedReq.DbName,
-		CollectionName:     wrappedReq.CollectionName,
-		PartitionNames:     wrappedReq.PartitionNames,
-		Dsl:                wrappedReq.Dsl,
-		DslType:            wrappedReq.DslType,
-		OutputFields:       wrappedReq.OutputFields,
-		SearchParams:       wrappedReq.SearchParams,
-		TravelTimestamp:    wrappedReq.TravelTimestamp,
-		GuaranteeTimestamp: wrappedReq.GuaranteeTimestamp,
-		Nq:                 wrappedReq.Nq,
+	return h.proxy.Search(c, wrappedReq.AsSearchRequest())
+}
+
+func (h *Handlers) handleHybridSearch(c *gin.Context) (interface{}, error) {
+	wrappedReq := HybridSearchRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	if len(wrappedReq.BinaryVectors) > 0 {
-		req.PlaceholderGroup = binaryVector2Bytes(wrappedReq.BinaryVectors)
-	} else {
-		req.PlaceholderGroup = vector2Bytes(wrappedReq.Vectors)
+	reqs := make([]*milvuspb.SearchRequest, 0, len(wrappedReq.Requests))
+	for _, subReq := range wrappedReq.Requests {
+		reqs = append(reqs, subReq.AsSearchRequest())
 	}
-	return h.proxy.Search(c, &req)
+	return h.proxy.HybridSearch(c, reqs, wrappedReq.RankParams)
 }
 
 func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
@@ -374,6 +372,22 @@ func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
 	return h.proxy.Query(c, &req)
 }
 
+func (h *Handlers) handleSQL(c *gin.Context) (interface{}, error) {
+	wrappedReq := SQLRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	stmt, err := sqlparser.Parse(wrappedReq.SQL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse sql failed: %v", errBadRequest, err)
+	}
+	if stmt.IsSearch() {
+		return h.proxy.Search(c, wrappedReq.AsSearchRequest(stmt))
+	}
+	return h.proxy.Query(c, wrappedReq.AsQueryRequest(stmt))
+}
+
 func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
 	req := milvuspb.FlushRequest{}
 	err := shouldBind(c, &req)
@@ -170,6 +170,13 @@ func (m *mockProxyComponent) Search(ctx context.Context, request *milvuspb.Searc
 	return &searchResult, nil
 }
 
+func (m *mockProxyComponent) HybridSearch(ctx context.Context, requests []*milvuspb.SearchRequest, rankParams []*commonpb.KeyValuePair) (*milvuspb.SearchResults, error) {
+	if len(requests) == 0 || len(rankParams) == 0 {
+		return nil, errors.New("body parse err")
+	}
+	return &searchResult, nil
+}
+
 var queryResult = milvuspb.QueryResults{
 	CollectionName: "test",
 }
@@ -314,6 +321,13 @@ func TestHandlers(t *testing.T) {
 		testEngine.ServeHTTP(w, req)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
+	t.Run("handleSQL invalid sql 400", func(t *testing.T) {
+		bodyBytes, _ := json.Marshal(SQLRequest{SQL: "DELETE FROM c1"})
+		req := httptest.NewRequest(http.MethodPost, "/sql", bytes.NewReader(bodyBytes))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 	t.Run("handlePostDummy default json ok", func(t *testing.T) {
 		bodyBytes := []byte("")
 		req := httptest.NewRequest(http.MethodPost, "/dummy", bytes.NewReader(bodyBytes))
@@ -447,10 +461,27 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/search", milvuspb.SearchRequest{Dsl: "some dsl"},
 			http.StatusOK, &searchResult,
 		},
+		{
+			http.MethodPost, "/hybrid-search", HybridSearchRequest{
+				Requests: []*SearchRequest{{Dsl: "some dsl"}, {Dsl: "other dsl"}},
+				RankParams: []*commonpb.KeyValuePair{
+					{Key: "strategy", Value: "rrf"},
+					{Key: "limit", Value: "10"},
+				}},
+			http.StatusOK, &searchResult,
+		},
 		{
 			http.MethodPost, "/query", milvuspb.QueryRequest{Expr: "some expr"},
 			http.StatusOK, &queryResult,
 		},
+		{
+			http.MethodPost, "/sql", SQLRequest{SQL: "SELECT id FROM c1 WHERE id > 1 LIMIT 10"},
+			http.StatusOK, &queryResult,
+		},
+		{
+			http.MethodPost, "/sql", SQLRequest{SQL: "SELECT id FROM c1 ORDER BY VECTOR_SEARCH(vec, [0.1, 0.2], 'L2') LIMIT 10"},
+			http.StatusOK, &searchResult,
+		},
 		{
 			http.MethodPost, "/persist", milvuspb.FlushRequest{CollectionNames: []string{"c1"}},
 			http.StatusOK, flushResult,
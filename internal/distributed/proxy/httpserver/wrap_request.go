@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/sqlparser"
 )
 
 // We wrap original protobuf structure for 2 reasons:
@@ -251,6 +253,36 @@ type SearchRequest struct {
 	Nq                 int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
 }
 
+// AsSearchRequest converts the SearchRequest to milvuspb.SearchRequest
+func (w *SearchRequest) AsSearchRequest() *milvuspb.SearchRequest {
+	req := &milvuspb.SearchRequest{
+		Base:               w.Base,
+		DbName:             w.DbName,
+		CollectionName:     w.CollectionName,
+		PartitionNames:     w.PartitionNames,
+		Dsl:                w.Dsl,
+		DslType:            w.DslType,
+		OutputFields:       w.OutputFields,
+		SearchParams:       w.SearchParams,
+		TravelTimestamp:    w.TravelTimestamp,
+		GuaranteeTimestamp: w.GuaranteeTimestamp,
+		Nq:                 w.Nq,
+	}
+	if len(w.BinaryVectors) > 0 {
+		req.PlaceholderGroup = binaryVector2Bytes(w.BinaryVectors)
+	} else {
+		req.PlaceholderGroup = vector2Bytes(w.Vectors)
+	}
+	return req
+}
+
+// HybridSearchRequest is the HybridSearch request wrapped for RESTful request,
+// it holds one SearchRequest per vector field and the params to fuse their results.
+type HybridSearchRequest struct {
+	Requests   []*SearchRequest         `json:"requests,omitempty"`
+	RankParams []*commonpb.KeyValuePair `json:"rank_params,omitempty"`
+}
+
 func binaryVector2Bytes(vectors [][]byte) []byte {
 	ph := &commonpb.PlaceholderValue{
 		Tag:    "$0",
@@ -379,3 +411,48 @@ type VectorIDs struct {
 	PartitionNames []string `json:"partition_names"`
 	IDArray        []int64  `json:"id_array,omitempty"`
 }
+
+// SQLRequest is the RESTful request body for the SQL-like statement,
+// see package sqlparser for the supported syntax.
+type SQLRequest struct {
+	DbName string `json:"db_name,omitempty"`
+	SQL    string `json:"sql,omitempty"`
+}
+
+// AsSearchRequest compiles the parsed search statement to milvuspb.SearchRequest
+func (w *SQLRequest) AsSearchRequest(stmt *sqlparser.Statement) *milvuspb.SearchRequest {
+	vs := stmt.VectorSearch
+	return &milvuspb.SearchRequest{
+		DbName:         w.DbName,
+		CollectionName: stmt.Collection,
+		Dsl:            stmt.Filter,
+		DslType:        commonpb.DslType_BoolExprV1,
+		OutputFields:   stmt.OutputFields,
+		SearchParams: []*commonpb.KeyValuePair{
+			{Key: "anns_field", Value: vs.FieldName},
+			{Key: "topk", Value: strconv.FormatInt(stmt.Limit, 10)},
+			{Key: "offset", Value: strconv.FormatInt(stmt.Offset, 10)},
+			{Key: "metric_type", Value: vs.MetricType},
+			{Key: "params", Value: vs.Params},
+		},
+		PlaceholderGroup: vector2Bytes([][]float32{vs.Vector}),
+		Nq:               1,
+	}
+}
+
+// AsQueryRequest compiles the parsed query statement to milvuspb.QueryRequest
+func (w *SQLRequest) AsQueryRequest(stmt *sqlparser.Statement) *milvuspb.QueryRequest {
+	req := &milvuspb.QueryRequest{
+		DbName:         w.DbName,
+		CollectionName: stmt.Collection,
+		Expr:           stmt.Filter,
+		OutputFields:   stmt.OutputFields,
+	}
+	if stmt.Limit != sqlparser.Unlimited {
+		req.QueryParams = []*commonpb.KeyValuePair{
+			{Key: "limit", Value: strconv.FormatInt(stmt.Limit, 10)},
+			{Key: "offset", Value: strconv.FormatInt(stmt.Offset, 10)},
+		}
+	}
+	return req
+}
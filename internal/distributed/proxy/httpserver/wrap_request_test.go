@@ -6,6 +6,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/sqlparser"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -283,3 +284,38 @@ func TestVectorsArray_AsPbVectorArray(t *testing.T) {
 		assert.Equal(t, ids, ints.IntId.Data)
 	})
 }
+
+func TestSQLRequest(t *testing.T) {
+	w := &SQLRequest{DbName: "db"}
+	t.Run("search", func(t *testing.T) {
+		stmt, err := sqlparser.Parse("SELECT id FROM c1 WHERE id > 1 ORDER BY VECTOR_SEARCH(vec, [0.1, 0.2], 'IP') LIMIT 5 OFFSET 2")
+		assert.NoError(t, err)
+		req := w.AsSearchRequest(stmt)
+		assert.Equal(t, "db", req.GetDbName())
+		assert.Equal(t, "c1", req.GetCollectionName())
+		assert.Equal(t, "id > 1", req.GetDsl())
+		assert.Equal(t, int64(1), req.GetNq())
+		params := make(map[string]string)
+		for _, kv := range req.GetSearchParams() {
+			params[kv.GetKey()] = kv.GetValue()
+		}
+		assert.Equal(t, "vec", params["anns_field"])
+		assert.Equal(t, "5", params["topk"])
+		assert.Equal(t, "2", params["offset"])
+		assert.Equal(t, "IP", params["metric_type"])
+		assert.Equal(t, vector2Bytes([][]float32{{0.1, 0.2}}), req.GetPlaceholderGroup())
+	})
+	t.Run("query", func(t *testing.T) {
+		stmt, err := sqlparser.Parse("SELECT id, title FROM c1 WHERE id > 1")
+		assert.NoError(t, err)
+		req := w.AsQueryRequest(stmt)
+		assert.Equal(t, "id > 1", req.GetExpr())
+		assert.Equal(t, []string{"id", "title"}, req.GetOutputFields())
+		assert.Empty(t, req.GetQueryParams())
+
+		stmt, err = sqlparser.Parse("SELECT id FROM c1 LIMIT 10 OFFSET 3")
+		assert.NoError(t, err)
+		req = w.AsQueryRequest(stmt)
+		assert.Len(t, req.GetQueryParams(), 2)
+	})
+}
@@ -19,10 +19,8 @@ package grpcproxy
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
@@ -33,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 	"github.com/milvus-io/milvus/internal/util/componentutil"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/pkg/tracer"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -61,6 +60,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	_ "google.golang.org/grpc/encoding/gzip" // register gzip compressor for clients compressing requests with gzip
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
@@ -143,6 +143,8 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	var kasp = keepalive.ServerParameters{
 		Time:    60 * time.Second, // Ping the client if it is idle for 60 seconds to ensure the connection is still active
 		Timeout: 10 * time.Second, // Wait 10 second for the ping ack before assuming the connection is dead
+		// Close the connection of abandoned client, which is also expired from connection manager
+		MaxConnectionIdle: proxy.Params.ProxyCfg.ConnectionIdleTimeout.GetAsDuration(time.Second),
 	}
 
 	log.Debug("Proxy server listen on tcp", zap.Int("port", grpcPort))
@@ -171,50 +173,40 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			otelgrpc.UnaryServerInterceptor(opts...),
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.ConnectionInterceptor(),
 			proxy.UnaryServerHookInterceptor(),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
 			proxy.RateLimitInterceptor(limiter),
 			accesslog.UnaryAccessLoggerInterceptor,
+			accesslog.UnaryAuditLoggerInterceptor,
 		)),
 	}
 
+	if proxy.Params.ProxyCfg.ResponseCompressionEnabled.GetAsBool() {
+		// gzip is the only compressor which all the grpc implementations are required to support
+		grpcOpts = append(grpcOpts, grpc.RPCCompressor(grpc.NewGZIPCompressor()))
+	}
+
+	// certificates are reloaded on modification, so they could be rotated without restart
 	if Params.TLSMode.GetAsInt() == 1 {
-		creds, err := credentials.NewServerTLSFromFile(Params.ServerPemPath.GetValue(), Params.ServerKeyPath.GetValue())
+		reloader, err := tlsutil.GetCertReloader(Params.ServerPemPath.GetValue(), Params.ServerKeyPath.GetValue(), "",
+			Params.TLSReloadInterval.GetAsDuration(time.Second))
 		if err != nil {
 			log.Warn("proxy can't create creds", zap.Error(err))
 			errChan <- err
 			return
 		}
-		grpcOpts = append(grpcOpts, grpc.Creds(creds))
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig(tls.NoClientCert, tls.VersionTLS12))))
 	} else if Params.TLSMode.GetAsInt() == 2 {
-		cert, err := tls.LoadX509KeyPair(Params.ServerPemPath.GetValue(), Params.ServerKeyPath.GetValue())
+		reloader, err := tlsutil.GetCertReloader(Params.ServerPemPath.GetValue(), Params.ServerKeyPath.GetValue(),
+			Params.CaPemPath.GetValue(), Params.TLSReloadInterval.GetAsDuration(time.Second))
 		if err != nil {
-			log.Warn("proxy cant load x509 key pair", zap.Error(err))
+			log.Warn("proxy can't create mutual tls creds", zap.Error(err))
 			errChan <- err
 			return
 		}
-
-		certPool := x509.NewCertPool()
-		rootBuf, err := ioutil.ReadFile(Params.CaPemPath.GetValue())
-		if err != nil {
-			log.Warn("failed read ca pem", zap.Error(err))
-			errChan <- err
-			return
-		}
-		if !certPool.AppendCertsFromPEM(rootBuf) {
-			log.Warn("fail to append ca to cert")
-			errChan <- fmt.Errorf("fail to append ca to cert")
-			return
-		}
-
-		tlsConf := &tls.Config{
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-			Certificates: []tls.Certificate{cert},
-			ClientCAs:    certPool,
-			MinVersion:   tls.VersionTLS13,
-		}
-		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConf)))
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig(tls.RequireAndVerifyClientCert, tls.VersionTLS13))))
 	}
 	s.grpcExternalServer = grpc.NewServer(grpcOpts...)
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
@@ -254,8 +246,15 @@ func (s *Server) startInternalGrpc(grpcPort int, errChan chan error) {
 	}
 	log.Debug("Proxy internal server already listen on tcp", zap.Int("port", grpcPort))
 
+	tlsOpts, err := tlsutil.InternalServerOptions(Params)
+	if err != nil {
+		log.Warn("failed to create tls options for Proxy internal grpc server", zap.Error(err))
+		errChan <- err
+		return
+	}
+
 	opts := tracer.GetInterceptorOpts()
-	s.grpcInternalServer = grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize.GetAsInt()),
@@ -264,7 +263,8 @@ func (s *Server) startInternalGrpc(grpcPort int, errChan chan error) {
 			otelgrpc.UnaryServerInterceptor(opts...),
 			logutil.UnaryTraceLoggerInterceptor,
 		)),
-	)
+	}
+	s.grpcInternalServer = grpc.NewServer(append(grpcOpts, tlsOpts...)...)
 	proxypb.RegisterProxyServer(s.grpcInternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcInternalServer, s)
 	errChan <- nil
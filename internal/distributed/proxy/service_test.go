@@ -618,6 +618,10 @@ func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) HybridSearch(ctx context.Context, requests []*milvuspb.SearchRequest, rankParams []*commonpb.KeyValuePair) (*milvuspb.SearchResults, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return nil, nil
 }
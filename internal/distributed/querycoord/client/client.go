@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -54,6 +55,10 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 		return nil, err
 	}
 	clientParams := &Params.QueryCoordGrpcClientCfg
+	tlsConfig, err := tlsutil.InternalClientConfig(clientParams)
+	if err != nil {
+		return nil, err
+	}
 	client := &Client{
 		grpcClient: &grpcclient.ClientBase[querypb.QueryCoordClient]{
 			ClientMaxRecvSize:      clientParams.ClientMaxRecvSize.GetAsInt(),
@@ -67,6 +72,7 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 			MaxBackoff:             float32(clientParams.MaxBackoff.GetAsFloat()),
 			BackoffMultiplier:      float32(clientParams.BackoffMultiplier.GetAsFloat()),
 			CompressionEnabled:     clientParams.CompressionEnabled.GetAsBool(),
+			InternalTLSConfig:      tlsConfig,
 		},
 		sess: sess,
 	}
@@ -0,0 +1,365 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// sqlparser package parses a restricted SQL dialect into statements which could be compiled
+// to search or query requests:
+//
+//	SELECT field1, field2 FROM collection
+//	[WHERE boolean_expr]
+//	[ORDER BY VECTOR_SEARCH(vector_field, [0.1, 0.2, ...], 'L2'[, '{"nprobe": 10}'])]
+//	[LIMIT k [OFFSET n]]
+//
+// The WHERE clause is passed through as a Milvus boolean expression.
+package sqlparser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	keywordSelect  = "SELECT"
+	keywordFrom    = "FROM"
+	keywordWhere   = "WHERE"
+	keywordOrderBy = "ORDER BY"
+	keywordLimit   = "LIMIT"
+	keywordOffset  = "OFFSET"
+
+	// VectorSearchFunc is the only function supported in ORDER BY clause.
+	VectorSearchFunc = "VECTOR_SEARCH"
+
+	// Unlimited means LIMIT clause is not specified.
+	Unlimited int64 = -1
+)
+
+// clause order in a statement, each keyword could only appear after the former ones.
+var clauseKeywords = []string{keywordSelect, keywordFrom, keywordWhere, keywordOrderBy, keywordLimit, keywordOffset}
+
+// VectorSearch is the parsed VECTOR_SEARCH function.
+type VectorSearch struct {
+	FieldName  string
+	Vector     []float32
+	MetricType string
+	// Params is the json formatted search params, "{}" if not specified.
+	Params string
+}
+
+// Statement is the parsed SELECT statement.
+type Statement struct {
+	OutputFields []string
+	Collection   string
+	Filter       string
+	VectorSearch *VectorSearch
+	Limit        int64
+	Offset       int64
+}
+
+// IsSearch returns whether the statement should be executed as a vector search.
+func (s *Statement) IsSearch() bool {
+	return s.VectorSearch != nil
+}
+
+// Parse parses sql into Statement.
+func Parse(sql string) (*Statement, error) {
+	sql = strings.TrimSpace(sql)
+	sql = strings.TrimSpace(strings.TrimSuffix(sql, ";"))
+	if sql == "" {
+		return nil, errors.New("empty sql statement")
+	}
+
+	clauses, err := splitClauses(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := &Statement{
+		Limit: Unlimited,
+	}
+	if stmt.OutputFields, err = parseSelectList(clauses[keywordSelect]); err != nil {
+		return nil, err
+	}
+
+	collection, ok := clauses[keywordFrom]
+	if !ok {
+		return nil, errors.New("FROM clause is required")
+	}
+	if !isIdentifier(collection) {
+		return nil, fmt.Errorf("invalid collection name: %s", collection)
+	}
+	stmt.Collection = collection
+
+	if filter, ok := clauses[keywordWhere]; ok {
+		if filter == "" {
+			return nil, errors.New("WHERE clause is empty")
+		}
+		stmt.Filter = filter
+	}
+
+	if orderBy, ok := clauses[keywordOrderBy]; ok {
+		if stmt.VectorSearch, err = parseVectorSearch(orderBy); err != nil {
+			return nil, err
+		}
+	}
+
+	if limit, ok := clauses[keywordLimit]; ok {
+		stmt.Limit, err = strconv.ParseInt(limit, 10, 64)
+		if err != nil || stmt.Limit <= 0 {
+			return nil, fmt.Errorf("LIMIT should be a positive integer, got %s", limit)
+		}
+	}
+
+	if offset, ok := clauses[keywordOffset]; ok {
+		if stmt.Limit == Unlimited {
+			return nil, errors.New("OFFSET requires LIMIT")
+		}
+		stmt.Offset, err = strconv.ParseInt(offset, 10, 64)
+		if err != nil || stmt.Offset < 0 {
+			return nil, fmt.Errorf("OFFSET should be a non-negative integer, got %s", offset)
+		}
+	}
+
+	if stmt.IsSearch() && stmt.Limit == Unlimited {
+		return nil, fmt.Errorf("LIMIT is required when ordering by %s", VectorSearchFunc)
+	}
+
+	return stmt, nil
+}
+
+// splitClauses splits sql into clauses by the top level keywords, keywords inside quotes or brackets are ignored.
+func splitClauses(sql string) (map[string]string, error) {
+	type position struct {
+		keyword string
+		start   int
+		end     int
+	}
+	positions := make([]position, 0, len(clauseKeywords))
+
+	var quote byte
+	depth := 0
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		case c == '\'' || c == '"':
+			quote = c
+			continue
+		case c == '(' || c == '[':
+			depth++
+			continue
+		case c == ')' || c == ']':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced brackets at position %d", i)
+			}
+			continue
+		}
+		if depth > 0 || (i > 0 && isIdentChar(sql[i-1])) {
+			continue
+		}
+		for _, keyword := range clauseKeywords {
+			if end, ok := matchKeyword(sql, i, keyword); ok {
+				positions = append(positions, position{keyword: keyword, start: i, end: end})
+				i = end - 1
+				break
+			}
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quoted string")
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced brackets")
+	}
+
+	if len(positions) == 0 || positions[0].keyword != keywordSelect || positions[0].start != 0 {
+		return nil, errors.New("only SELECT statement is supported")
+	}
+
+	clauses := make(map[string]string, len(positions))
+	order := 0
+	for i, pos := range positions {
+		idx := indexOf(clauseKeywords, pos.keyword)
+		if idx < order || (i > 0 && idx == order) {
+			return nil, fmt.Errorf("unexpected %s clause", pos.keyword)
+		}
+		order = idx
+		end := len(sql)
+		if i+1 < len(positions) {
+			end = positions[i+1].start
+		}
+		clauses[pos.keyword] = strings.TrimSpace(sql[pos.end:end])
+	}
+	return clauses, nil
+}
+
+// matchKeyword checks whether keyword starts at position i of sql, returns the end position of the keyword.
+// Words of keyword could be separated by any whitespaces.
+func matchKeyword(sql string, i int, keyword string) (int, bool) {
+	pos := i
+	for wi, word := range strings.Fields(keyword) {
+		if wi > 0 {
+			start := pos
+			for pos < len(sql) && isSpace(sql[pos]) {
+				pos++
+			}
+			if pos == start {
+				return 0, false
+			}
+		}
+		if len(sql)-pos < len(word) || !strings.EqualFold(sql[pos:pos+len(word)], word) {
+			return 0, false
+		}
+		pos += len(word)
+	}
+	if pos < len(sql) && isIdentChar(sql[pos]) {
+		return 0, false
+	}
+	return pos, true
+}
+
+func parseSelectList(selectList string) ([]string, error) {
+	if selectList == "" {
+		return nil, errors.New("SELECT list is empty")
+	}
+	fields := make([]string, 0)
+	for _, field := range splitTopLevel(selectList, ',') {
+		field = strings.TrimSpace(field)
+		if field != "*" && !isIdentifier(field) {
+			return nil, fmt.Errorf("invalid field in SELECT list: %s", field)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func parseVectorSearch(orderBy string) (*VectorSearch, error) {
+	open := strings.Index(orderBy, "(")
+	if open < 0 || !strings.HasSuffix(orderBy, ")") ||
+		!strings.EqualFold(strings.TrimSpace(orderBy[:open]), VectorSearchFunc) {
+		return nil, fmt.Errorf("only %s function is supported in ORDER BY clause, got %s", VectorSearchFunc, orderBy)
+	}
+
+	args := splitTopLevel(orderBy[open+1:len(orderBy)-1], ',')
+	if len(args) < 3 || len(args) > 4 {
+		return nil, fmt.Errorf("%s expects 3 or 4 arguments: (vector_field, vector, metric_type[, params]), got %d",
+			VectorSearchFunc, len(args))
+	}
+	for i := range args {
+		args[i] = strings.TrimSpace(args[i])
+	}
+
+	vs := &VectorSearch{
+		FieldName: args[0],
+		Params:    "{}",
+	}
+	if !isIdentifier(vs.FieldName) {
+		return nil, fmt.Errorf("invalid vector field name: %s", vs.FieldName)
+	}
+	if err := json.Unmarshal([]byte(args[1]), &vs.Vector); err != nil || len(vs.Vector) == 0 {
+		return nil, fmt.Errorf("invalid vector: %s", args[1])
+	}
+
+	var err error
+	if vs.MetricType, err = unquote(args[2]); err != nil {
+		return nil, fmt.Errorf("invalid metric type, %w", err)
+	}
+	if len(args) == 4 {
+		if vs.Params, err = unquote(args[3]); err != nil {
+			return nil, fmt.Errorf("invalid search params, %w", err)
+		}
+		if !json.Valid([]byte(vs.Params)) {
+			return nil, fmt.Errorf("search params should be json formatted, got %s", vs.Params)
+		}
+	}
+	return vs, nil
+}
+
+// splitTopLevel splits s by sep which is not inside quotes or brackets.
+func splitTopLevel(s string, sep byte) []string {
+	var (
+		parts []string
+		quote byte
+		depth int
+		last  int
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, s[last:i])
+			last = i + 1
+		}
+	}
+	return append(parts, s[last:])
+}
+
+func unquote(s string) (string, error) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", fmt.Errorf("%s is not a quoted string", s)
+	}
+	return s[1 : len(s)-1], nil
+}
+
+func isIdentifier(s string) bool {
+	if s == "" || (s[0] >= '0' && s[0] <= '9') {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isIdentChar(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func indexOf(keywords []string, keyword string) int {
+	for i, k := range keywords {
+		if k == keyword {
+			return i
+		}
+	}
+	return -1
+}
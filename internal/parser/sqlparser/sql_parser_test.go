@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Query(t *testing.T) {
+	stmt, err := Parse(`select id, title from books where year > 2000 and title like "from%" limit 10 offset 5;`)
+	assert.NoError(t, err)
+	assert.False(t, stmt.IsSearch())
+	assert.Equal(t, []string{"id", "title"}, stmt.OutputFields)
+	assert.Equal(t, "books", stmt.Collection)
+	assert.Equal(t, `year > 2000 and title like "from%"`, stmt.Filter)
+	assert.Equal(t, int64(10), stmt.Limit)
+	assert.Equal(t, int64(5), stmt.Offset)
+
+	stmt, err = Parse("SELECT * FROM books")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"*"}, stmt.OutputFields)
+	assert.Equal(t, "", stmt.Filter)
+	assert.Equal(t, Unlimited, stmt.Limit)
+}
+
+func TestParse_Search(t *testing.T) {
+	stmt, err := Parse(`SELECT id FROM books WHERE id in [1, 2, 3]
+		ORDER  BY vector_search(embedding, [0.1, 0.2, 0.3], 'L2', '{"nprobe": 16}') LIMIT 3`)
+	assert.NoError(t, err)
+	assert.True(t, stmt.IsSearch())
+	assert.Equal(t, "id in [1, 2, 3]", stmt.Filter)
+	assert.Equal(t, "embedding", stmt.VectorSearch.FieldName)
+	assert.Equal(t, []float32{0.1, 0.2, 0.3}, stmt.VectorSearch.Vector)
+	assert.Equal(t, "L2", stmt.VectorSearch.MetricType)
+	assert.Equal(t, `{"nprobe": 16}`, stmt.VectorSearch.Params)
+	assert.Equal(t, int64(3), stmt.Limit)
+
+	stmt, err = Parse(`SELECT id FROM books ORDER BY VECTOR_SEARCH(embedding, [1], "IP") LIMIT 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, "{}", stmt.VectorSearch.Params)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	invalids := []string{
+		"",
+		"DELETE FROM books",
+		"SELECT FROM books",
+		"SELECT id",
+		"SELECT id FROM",
+		"SELECT id FROM books WHERE",
+		"SELECT id FROM 1books",
+		"SELECT id, 1a FROM books",
+		"SELECT id FROM books LIMIT 0",
+		"SELECT id FROM books LIMIT a",
+		"SELECT id FROM books OFFSET 1",
+		"SELECT id FROM books LIMIT 1 OFFSET -1",
+		"SELECT id FROM books LIMIT 1 WHERE id > 1",
+		"SELECT id FROM books WHERE id > 1 WHERE id < 2",
+		"SELECT id FROM books WHERE title == 'abc",
+		"SELECT id FROM books WHERE id in [1, 2",
+		"SELECT id FROM books ORDER BY id LIMIT 1",
+		"SELECT id FROM books ORDER BY VECTOR_SEARCH(embedding, [0.1], 'L2')",
+		"SELECT id FROM books ORDER BY VECTOR_SEARCH(embedding, [0.1]) LIMIT 1",
+		"SELECT id FROM books ORDER BY VECTOR_SEARCH(embedding, [], 'L2') LIMIT 1",
+		"SELECT id FROM books ORDER BY VECTOR_SEARCH(embedding, [0.1], L2) LIMIT 1",
+		"SELECT id FROM books ORDER BY VECTOR_SEARCH(embedding, [0.1], 'L2', 'nprobe') LIMIT 1",
+	}
+	for _, sql := range invalids {
+		_, err := Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
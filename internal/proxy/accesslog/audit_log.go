@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// request categories of audit log
+const (
+	AuditDDL = "DDL"
+	AuditDCL = "DCL"
+	AuditDML = "DML"
+	AuditDQL = "DQL"
+
+	anonymousUser = "anonymous"
+)
+
+// auditMethods maps the audited grpc methods to their categories, methods not listed are not audited.
+var auditMethods = map[string]string{
+	"CreateDatabase":    AuditDDL,
+	"DropDatabase":      AuditDDL,
+	"CreateCollection":  AuditDDL,
+	"DropCollection":    AuditDDL,
+	"AlterCollection":   AuditDDL,
+	"LoadCollection":    AuditDDL,
+	"ReleaseCollection": AuditDDL,
+	"CreatePartition":   AuditDDL,
+	"DropPartition":     AuditDDL,
+	"LoadPartitions":    AuditDDL,
+	"ReleasePartitions": AuditDDL,
+	"CreateAlias":       AuditDDL,
+	"DropAlias":         AuditDDL,
+	"AlterAlias":        AuditDDL,
+	"CreateIndex":       AuditDDL,
+	"DropIndex":         AuditDDL,
+	"Flush":             AuditDDL,
+	"ManualCompaction":  AuditDDL,
+
+	"CreateCredential": AuditDCL,
+	"UpdateCredential": AuditDCL,
+	"DeleteCredential": AuditDCL,
+	"CreateRole":       AuditDCL,
+	"DropRole":         AuditDCL,
+	"OperateUserRole":  AuditDCL,
+	"OperatePrivilege": AuditDCL,
+
+	"Insert": AuditDML,
+	"Delete": AuditDML,
+	"Upsert": AuditDML,
+	"Import": AuditDML,
+
+	"Search":       AuditDQL,
+	"Query":        AuditDQL,
+	"CalcDistance": AuditDQL,
+}
+
+type auditLogger struct {
+	logger     *zap.Logger
+	categories typeutil.Set[string]
+}
+
+var _globalAudit atomic.Value
+var auditOnce sync.Once
+
+func SetupAuditLog(logCfg *paramtable.AuditLogConfig, minioCfg *paramtable.MinioConfig) {
+	auditOnce.Do(func() {
+		_, err := InitAuditLogger(logCfg, minioCfg)
+		if err != nil {
+			log.Fatal("initialize audit logger error", zap.Error(err))
+		}
+	})
+}
+
+// InitAuditLogger initializes a zap audit logger for proxy,
+// audit log is written to rotated files which could be uploaded to minio, or stdout if filename is empty.
+func InitAuditLogger(logCfg *paramtable.AuditLogConfig, minioCfg *paramtable.MinioConfig) (*RotateLogger, error) {
+	if !logCfg.Enable.GetAsBool() {
+		return nil, nil
+	}
+
+	var lg *RotateLogger
+	var writeSyncer zapcore.WriteSyncer
+	if len(logCfg.Filename.GetValue()) > 0 {
+		var err error
+		lg, err = NewRotateLogger(&logCfg.AccessLogConfig, minioCfg)
+		if err != nil {
+			return nil, err
+		}
+		writeSyncer = zapcore.AddSync(lg)
+	} else {
+		stdout, _, err := zap.Open([]string{"stdout"}...)
+		if err != nil {
+			return nil, err
+		}
+		writeSyncer = stdout
+	}
+
+	categories := typeutil.NewSet[string]()
+	for _, category := range strings.Split(logCfg.Categories.GetValue(), ",") {
+		categories.Insert(strings.ToUpper(strings.TrimSpace(category)))
+	}
+
+	logger := zap.New(zapcore.NewCore(NewAccessEncoder(), writeSyncer, zapcore.DebugLevel))
+	logger.Info("Audit log start successful")
+
+	_globalAudit.Store(&auditLogger{
+		logger:     logger,
+		categories: categories,
+	})
+	return lg, nil
+}
+
+// UnaryAuditLoggerInterceptor records who executed which request, with the summary of parameters,
+// the result status and the latency.
+func UnaryAuditLoggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	PrintAuditInfo(ctx, req, resp, err, info, time.Since(start))
+	return resp, err
+}
+
+func PrintAuditInfo(ctx context.Context, req interface{}, resp interface{}, err error, rpcInfo *grpc.UnaryServerInfo, timeCost time.Duration) bool {
+	audit, ok := _globalAudit.Load().(*auditLogger)
+	if !ok {
+		return false
+	}
+
+	_, methodName := path.Split(rpcInfo.FullMethod)
+	category, ok := auditMethods[methodName]
+	if !ok || !audit.categories.Contain(category) {
+		return false
+	}
+
+	user, ok := getCurUser(ctx)
+	if !ok {
+		user = anonymousUser
+	}
+
+	fields := []zap.Field{
+		zap.String("user", user),
+		zap.String("address", getAccessAddr(ctx)),
+		zap.String("category", category),
+		zap.String("method", methodName),
+	}
+	fields = append(fields, getRequestSummary(req)...)
+
+	errCode, ok := getErrCode(resp)
+	if status, isStatus := resp.(*commonpb.Status); isStatus {
+		errCode, ok = int(status.GetErrorCode()), true
+	}
+	if !ok {
+		errCode = -1
+	}
+	status := getGrpcStatus(err)
+	if status == "OK" && errCode > 0 {
+		status = "TaskFailed"
+	}
+	fields = append(fields,
+		zap.String("status", status),
+		zap.Int("errorCode", errCode),
+		zap.String("timeCost", timeCost.String()),
+	)
+	if reason := getErrReason(resp, err); reason != "" {
+		fields = append(fields, zap.String("reason", reason))
+	}
+
+	audit.logger.Info("audit", fields...)
+	return true
+}
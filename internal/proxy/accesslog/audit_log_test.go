@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"net"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAuditLogger(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init()
+
+	ctx := peer.NewContext(
+		context.Background(),
+		&peer.Peer{
+			Addr: &net.IPAddr{
+				IP:   net.IPv4(0, 0, 0, 0),
+				Zone: "test",
+			},
+		})
+	token := crypto.Base64Encode("root" + util.CredentialSeperator + "Milvus")
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, token))
+
+	req := &milvuspb.DropCollectionRequest{DbName: "db", CollectionName: "c1"}
+	resp := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock failure"}
+	rpcInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection"}
+
+	t.Run("not enable", func(t *testing.T) {
+		_globalAudit = atomic.Value{}
+		Params.Save(Params.ProxyCfg.AuditLog.Enable.Key, "false")
+		defer Params.Reset(Params.ProxyCfg.AuditLog.Enable.Key)
+
+		lg, err := InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+		assert.NoError(t, err)
+		assert.Nil(t, lg)
+		assert.False(t, PrintAuditInfo(ctx, req, resp, nil, rpcInfo, time.Second))
+	})
+
+	t.Run("file", func(t *testing.T) {
+		testPath := "/tmp/audittest"
+		Params.Save(Params.ProxyCfg.AuditLog.Enable.Key, "true")
+		Params.Save(Params.ProxyCfg.AuditLog.LocalPath.Key, testPath)
+		defer Params.Reset(Params.ProxyCfg.AuditLog.Enable.Key)
+		defer Params.Reset(Params.ProxyCfg.AuditLog.LocalPath.Key)
+		defer os.RemoveAll(testPath)
+
+		lg, err := InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+		assert.NoError(t, err)
+		defer lg.Close()
+
+		assert.True(t, PrintAuditInfo(ctx, req, resp, nil, rpcInfo, time.Second))
+		// not audited method
+		assert.False(t, PrintAuditInfo(ctx, req, resp, nil, &grpc.UnaryServerInfo{FullMethod: "GetVersion"}, time.Second))
+	})
+
+	t.Run("categories", func(t *testing.T) {
+		Params.Save(Params.ProxyCfg.AuditLog.Enable.Key, "true")
+		Params.Save(Params.ProxyCfg.AuditLog.Filename.Key, "")
+		Params.Save(Params.ProxyCfg.AuditLog.Categories.Key, "dml, dql")
+		defer Params.Reset(Params.ProxyCfg.AuditLog.Enable.Key)
+		defer Params.Reset(Params.ProxyCfg.AuditLog.Filename.Key)
+		defer Params.Reset(Params.ProxyCfg.AuditLog.Categories.Key)
+
+		_, err := InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+		assert.NoError(t, err)
+
+		assert.False(t, PrintAuditInfo(ctx, req, resp, nil, rpcInfo, time.Second))
+		searchInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}
+		assert.True(t, PrintAuditInfo(context.Background(), &milvuspb.SearchRequest{CollectionName: "c1", Nq: 1},
+			&milvuspb.SearchResults{Status: &commonpb.Status{}}, nil, searchInfo, time.Second))
+	})
+}
+
+func TestGetRequestSummary(t *testing.T) {
+	fields := getRequestSummary(&milvuspb.InsertRequest{CollectionName: "c1", PartitionName: "p1", NumRows: 10})
+	assert.Len(t, fields, 3)
+
+	fields = getRequestSummary(&milvuspb.CreateCredentialRequest{Username: "user", Password: "secret"})
+	assert.Len(t, fields, 1)
+	assert.Equal(t, "user", fields[0].String)
+
+	fields = getRequestSummary(&milvuspb.QueryRequest{CollectionName: "c1", Expr: "id > 1"})
+	assert.Len(t, fields, 2)
+}
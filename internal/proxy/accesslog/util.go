@@ -26,7 +26,10 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -38,6 +41,20 @@ type BaseResponse interface {
 	GetStatus() *commonpb.Status
 }
 
+// getters of the request fields recorded by audit log
+type (
+	dbNameGetter         interface{ GetDbName() string }
+	collectionNameGetter interface{ GetCollectionName() string }
+	partitionNameGetter  interface{ GetPartitionName() string }
+	partitionNamesGetter interface{ GetPartitionNames() []string }
+	exprGetter           interface{ GetExpr() string }
+	dslGetter            interface{ GetDsl() string }
+	numRowsGetter        interface{ GetNumRows() uint32 }
+	nqGetter             interface{ GetNq() int64 }
+	usernameGetter       interface{ GetUsername() string }
+	roleNameGetter       interface{ GetRoleName() string }
+)
+
 func UnaryAccessLoggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	starttime := time.Now()
 	resp, err := handler(ctx, req)
@@ -107,3 +124,74 @@ func getGrpcStatus(err error) string {
 	}
 	return code.String()
 }
+
+// getCurUser returns the user name carried by the authorization header of the incoming request
+func getCurUser(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	authorization := md[strings.ToLower(util.HeaderAuthorize)]
+	if len(authorization) < 1 {
+		return "", false
+	}
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		return "", false
+	}
+	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		return "", false
+	}
+	return secrets[0], true
+}
+
+// getRequestSummary returns the fields identifying what the request operates on,
+// the payload such as vectors and passwords are never recorded.
+func getRequestSummary(req interface{}) []zap.Field {
+	fields := make([]zap.Field, 0)
+	if r, ok := req.(dbNameGetter); ok && r.GetDbName() != "" {
+		fields = append(fields, zap.String("db", r.GetDbName()))
+	}
+	if r, ok := req.(collectionNameGetter); ok && r.GetCollectionName() != "" {
+		fields = append(fields, zap.String("collection", r.GetCollectionName()))
+	}
+	if r, ok := req.(partitionNameGetter); ok && r.GetPartitionName() != "" {
+		fields = append(fields, zap.String("partition", r.GetPartitionName()))
+	}
+	if r, ok := req.(partitionNamesGetter); ok && len(r.GetPartitionNames()) > 0 {
+		fields = append(fields, zap.Strings("partitions", r.GetPartitionNames()))
+	}
+	if r, ok := req.(exprGetter); ok && r.GetExpr() != "" {
+		fields = append(fields, zap.String("expr", r.GetExpr()))
+	}
+	if r, ok := req.(dslGetter); ok && r.GetDsl() != "" {
+		fields = append(fields, zap.String("expr", r.GetDsl()))
+	}
+	if r, ok := req.(numRowsGetter); ok {
+		fields = append(fields, zap.Uint32("numRows", r.GetNumRows()))
+	}
+	if r, ok := req.(nqGetter); ok {
+		fields = append(fields, zap.Int64("nq", r.GetNq()))
+	}
+	if r, ok := req.(usernameGetter); ok && r.GetUsername() != "" {
+		fields = append(fields, zap.String("targetUser", r.GetUsername()))
+	}
+	if r, ok := req.(roleNameGetter); ok && r.GetRoleName() != "" {
+		fields = append(fields, zap.String("targetRole", r.GetRoleName()))
+	}
+	return fields
+}
+
+func getErrReason(resp interface{}, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	if baseResp, ok := resp.(BaseResponse); ok {
+		return baseResp.GetStatus().GetReason()
+	}
+	if status, ok := resp.(*commonpb.Status); ok {
+		return status.GetReason()
+	}
+	return ""
+}
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connection
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// ClientInfo is the information of a client connected to proxy.
+type ClientInfo struct {
+	// Address is the remote address of the connection, which identifies the client
+	Address        string    `json:"address"`
+	User           string    `json:"user,omitempty"`
+	UserAgent      string    `json:"user_agent,omitempty"`
+	SdkVersion     string    `json:"sdk_version,omitempty"`
+	ConnectedTime  time.Time `json:"connected_time"`
+	LastActiveTime time.Time `json:"last_active_time"`
+}
+
+// Manager tracks the clients connected to proxy, the clients idle longer than proxy.connection.idleTimeout are expired.
+type Manager struct {
+	mu      sync.RWMutex
+	clients map[string]*ClientInfo
+
+	startOnce sync.Once
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+}
+
+var (
+	manager     *Manager
+	managerOnce sync.Once
+)
+
+// GetManager returns the global connection manager.
+func GetManager() *Manager {
+	managerOnce.Do(func() {
+		manager = newManager()
+	})
+	return manager
+}
+
+func newManager() *Manager {
+	return &Manager{
+		clients: make(map[string]*ClientInfo),
+		closeCh: make(chan struct{}),
+	}
+}
+
+// Start starts the background loop expiring idle clients.
+func (m *Manager) Start() {
+	m.startOnce.Do(func() {
+		m.wg.Add(1)
+		go m.expireLoop()
+	})
+}
+
+// Close stops the background loop.
+func (m *Manager) Close() {
+	m.closeOnce.Do(func() {
+		close(m.closeCh)
+		m.wg.Wait()
+	})
+}
+
+func (m *Manager) expireLoop() {
+	defer m.wg.Done()
+	params := &paramtable.Get().ProxyCfg
+	ticker := time.NewTicker(params.ConnectionCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.closeCh:
+			return
+		case <-ticker.C:
+			m.expire(params.ConnectionIdleTimeout.GetAsDuration(time.Second))
+		}
+	}
+}
+
+// Update registers the client if it's new, otherwise refreshes its last active time.
+func (m *Manager) Update(address, user, userAgent, sdkVersion string) {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	client, ok := m.clients[address]
+	if !ok {
+		client = &ClientInfo{
+			Address:       address,
+			ConnectedTime: now,
+		}
+		m.clients[address] = client
+		log.Info("new client connected", zap.String("address", address),
+			zap.String("user", user), zap.String("userAgent", userAgent), zap.String("sdkVersion", sdkVersion))
+	}
+	client.LastActiveTime = now
+	// user may switch on the same connection, keep the latest one
+	if user != "" {
+		client.User = user
+	}
+	if userAgent != "" {
+		client.UserAgent = userAgent
+	}
+	if sdkVersion != "" {
+		client.SdkVersion = sdkVersion
+	}
+}
+
+// Remove removes the client of address.
+func (m *Manager) Remove(address string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.clients, address)
+}
+
+// List returns the copy of the clients sorted by address.
+func (m *Manager) List() []*ClientInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	clients := make([]*ClientInfo, 0, len(m.clients))
+	for _, client := range m.clients {
+		info := *client
+		clients = append(clients, &info)
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].Address < clients[j].Address
+	})
+	return clients
+}
+
+// expire removes the clients idle longer than idleTimeout, returns the number of removed clients.
+func (m *Manager) expire(idleTimeout time.Duration) int {
+	if idleTimeout <= 0 {
+		return 0
+	}
+	deadline := time.Now().Add(-idleTimeout)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	removed := 0
+	for address, client := range m.clients {
+		if client.LastActiveTime.Before(deadline) {
+			delete(m.clients, address)
+			removed++
+			log.Info("remove idle client", zap.String("address", address), zap.String("user", client.User),
+				zap.Time("lastActiveTime", client.LastActiveTime))
+		}
+	}
+	return removed
+}
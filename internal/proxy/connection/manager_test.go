@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestManager(t *testing.T) {
+	paramtable.Init()
+
+	m := newManager()
+	m.Update("127.0.0.1:10001", "root", "grpc-python/1.47.0", "2.2.8")
+	m.Update("127.0.0.1:10000", "", "grpc-go/1.54.0", "")
+	m.Update("127.0.0.1:10001", "", "", "")
+
+	clients := m.List()
+	assert.Len(t, clients, 2)
+	assert.Equal(t, "127.0.0.1:10000", clients[0].Address)
+	assert.Equal(t, "root", clients[1].User)
+	assert.Equal(t, "2.2.8", clients[1].SdkVersion)
+	assert.False(t, clients[1].LastActiveTime.Before(clients[1].ConnectedTime))
+
+	// the listed ones are copies
+	clients[0].User = "modified"
+	assert.Equal(t, "", m.List()[0].User)
+
+	m.Remove("127.0.0.1:10000")
+	assert.Len(t, m.List(), 1)
+
+	assert.Equal(t, 0, m.expire(time.Hour))
+	assert.Equal(t, 0, m.expire(0))
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 1, m.expire(time.Millisecond))
+	assert.Empty(t, m.List())
+}
+
+func TestManager_ExpireLoop(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.ConnectionCheckInterval.Key, "1")
+	params.Save(params.ProxyCfg.ConnectionIdleTimeout.Key, "1")
+	defer params.Reset(params.ProxyCfg.ConnectionCheckInterval.Key)
+	defer params.Reset(params.ProxyCfg.ConnectionIdleTimeout.Key)
+
+	m := newManager()
+	m.Start()
+	defer m.Close()
+
+	m.Update("127.0.0.1:10000", "root", "", "")
+	assert.Eventually(t, func() bool {
+		return len(m.List()) == 0
+	}, 5*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proxy/connection"
+)
+
+const (
+	userAgentKey  = "user-agent"
+	sdkVersionKey = "sdk_version"
+)
+
+// ConnectionInterceptor returns a new unary server interceptor which records the clients in connection manager.
+func ConnectionInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		updateClientInfo(ctx, connection.GetManager())
+		return handler(ctx, req)
+	}
+}
+
+func updateClientInfo(ctx context.Context, manager *connection.Manager) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return
+	}
+	// the user is unknown if authorization is not enabled
+	user, _ := GetCurUserFromContext(ctx)
+
+	var userAgent, sdkVersion string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(userAgentKey); len(values) > 0 {
+			userAgent = values[0]
+		}
+		if values := md.Get(sdkVersionKey); len(values) > 0 {
+			sdkVersion = values[0]
+		}
+	}
+	manager.Update(p.Addr.String(), user, userAgent, sdkVersion)
+}
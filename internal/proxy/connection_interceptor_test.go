@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+)
+
+func TestConnectionInterceptor(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 30000}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		util.HeaderAuthorize, crypto.Base64Encode("alice:passwd"),
+		userAgentKey, "grpc-python/1.47.0",
+		sdkVersionKey, "2.2.8",
+	))
+
+	interceptor := ConnectionInterceptor()
+	resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	var found *connection.ClientInfo
+	for _, client := range connection.GetManager().List() {
+		if client.Address == addr.String() {
+			found = client
+		}
+	}
+	assert.NotNil(t, found)
+	assert.Equal(t, "alice", found.User)
+	assert.Equal(t, "grpc-python/1.47.0", found.UserAgent)
+	assert.Equal(t, "2.2.8", found.SdkVersion)
+	connection.GetManager().Remove(addr.String())
+
+	// no peer in context, nothing recorded
+	updateClientInfo(context.Background(), connection.GetManager())
+}
@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/distance"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	RankTypeKey = "strategy"
+	RRFParamKey = "k"
+	WeightsKey  = "weights"
+
+	RRFRankType      = "rrf"
+	WeightedRankType = "weighted"
+
+	defaultRRFParamK = 60
+)
+
+type rankParams struct {
+	rankType string
+	k        float64
+	weights  []float64
+	limit    int64
+}
+
+// parseRankParams parses the fusion strategy used by hybrid search, searchNum is the number of sub searches.
+func parseRankParams(rankParamsPair []*commonpb.KeyValuePair, searchNum int) (*rankParams, error) {
+	params := &rankParams{
+		rankType: RRFRankType,
+		k:        defaultRRFParamK,
+	}
+
+	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, rankParamsPair)
+	if err != nil {
+		return nil, errors.New(LimitKey + " not found in rank_params")
+	}
+	params.limit, err = strconv.ParseInt(limitStr, 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s [%s] is invalid", LimitKey, limitStr)
+	}
+	if err := validateLimit(params.limit); err != nil {
+		return nil, fmt.Errorf("%s [%d] is invalid, %w", LimitKey, params.limit, err)
+	}
+
+	rankType, err := funcutil.GetAttrByKeyFromRepeatedKV(RankTypeKey, rankParamsPair)
+	if err == nil {
+		params.rankType = strings.ToLower(rankType)
+	}
+
+	switch params.rankType {
+	case RRFRankType:
+		kStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RRFParamKey, rankParamsPair)
+		if err == nil {
+			params.k, err = strconv.ParseFloat(kStr, 64)
+			if err != nil || params.k <= 0 {
+				return nil, fmt.Errorf("%s [%s] is invalid, should be a positive number", RRFParamKey, kStr)
+			}
+		}
+	case WeightedRankType:
+		weightsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(WeightsKey, rankParamsPair)
+		if err != nil {
+			return nil, errors.New(WeightsKey + " not found in rank_params")
+		}
+		if err := json.Unmarshal([]byte(weightsStr), &params.weights); err != nil {
+			return nil, fmt.Errorf("%s [%s] is invalid, should be a json array of numbers", WeightsKey, weightsStr)
+		}
+		if len(params.weights) != searchNum {
+			return nil, fmt.Errorf("the length of %s (%d) mis-match with the number of sub searches (%d)",
+				WeightsKey, len(params.weights), searchNum)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported rank strategy: %s", rankType)
+	}
+
+	return params, nil
+}
+
+// normalizeScore maps the score of given metric type into [0, 1], the larger the more similar.
+func normalizeScore(score float32, metricType string) float64 {
+	if distance.PositivelyRelated(metricType) {
+		return 0.5 + math.Atan(float64(score))/math.Pi
+	}
+	return 1.0 - 2*math.Atan(float64(score))/math.Pi
+}
+
+type fusedHit struct {
+	pk    interface{}
+	score float64
+	// the sub search and position of the hit which provides the output fields
+	searchIdx int
+	dataIdx   int64
+}
+
+// fuseSearchResults fuses the results of sub searches on different vector fields,
+// metricTypes holds the metric type of each sub search.
+func fuseSearchResults(ctx context.Context, results []*milvuspb.SearchResults, metricTypes []string, params *rankParams) (*milvuspb.SearchResults, error) {
+	if len(results) == 0 {
+		return nil, errors.New("no search results to fuse")
+	}
+	nq := results[0].GetResults().GetNumQueries()
+	for i, result := range results {
+		if result.GetResults().GetNumQueries() != nq {
+			return nil, fmt.Errorf("nq of sub search %d (%d) mis-match with %d", i, result.GetResults().GetNumQueries(), nq)
+		}
+	}
+
+	// sub searches without any hit may carry no fields data
+	fieldsNum := 0
+	for _, result := range results {
+		if n := len(result.GetResults().GetFieldsData()); n > fieldsNum {
+			fieldsNum = n
+		}
+	}
+
+	ret := &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CollectionName: results[0].GetCollectionName(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: nq,
+			TopK:       params.limit,
+			FieldsData: make([]*schemapb.FieldData, fieldsNum),
+			Scores:     []float32{},
+			Ids:        &schemapb.IDs{},
+			Topks:      []int64{},
+		},
+	}
+
+	// start offset of each query in each sub search
+	nqOffsets := make([][]int64, len(results))
+	for i, result := range results {
+		nqOffsets[i] = make([]int64, nq)
+		for j := int64(1); j < nq; j++ {
+			nqOffsets[i][j] = nqOffsets[i][j-1] + result.GetResults().GetTopks()[j-1]
+		}
+	}
+
+	for qi := int64(0); qi < nq; qi++ {
+		hits := make(map[interface{}]*fusedHit)
+		for i, result := range results {
+			data := result.GetResults()
+			if int64(len(data.GetTopks())) <= qi {
+				continue
+			}
+			start := nqOffsets[i][qi]
+			for rank := int64(0); rank < data.GetTopks()[qi]; rank++ {
+				idx := start + rank
+				pk := typeutil.GetPK(data.GetIds(), idx)
+				var score float64
+				if params.rankType == WeightedRankType {
+					score = params.weights[i] * normalizeScore(data.GetScores()[idx], metricTypes[i])
+				} else {
+					score = 1.0 / (params.k + float64(rank+1))
+				}
+				if hit, ok := hits[pk]; ok {
+					hit.score += score
+				} else {
+					hits[pk] = &fusedHit{pk: pk, score: score, searchIdx: i, dataIdx: idx}
+				}
+			}
+		}
+
+		sorted := make([]*fusedHit, 0, len(hits))
+		for _, hit := range hits {
+			sorted = append(sorted, hit)
+		}
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if sorted[i].score == sorted[j].score {
+				return fmt.Sprint(sorted[i].pk) < fmt.Sprint(sorted[j].pk)
+			}
+			return sorted[i].score > sorted[j].score
+		})
+		if int64(len(sorted)) > params.limit {
+			sorted = sorted[:params.limit]
+		}
+
+		for _, hit := range sorted {
+			typeutil.AppendPKs(ret.Results.Ids, hit.pk)
+			ret.Results.Scores = append(ret.Results.Scores, float32(hit.score))
+			typeutil.AppendFieldData(ret.Results.FieldsData, results[hit.searchIdx].GetResults().GetFieldsData(), hit.dataIdx)
+		}
+		ret.Results.Topks = append(ret.Results.Topks, int64(len(sorted)))
+	}
+
+	log.Ctx(ctx).Debug("fuse hybrid search results",
+		zap.Int("searchNum", len(results)),
+		zap.Int64("nq", nq),
+		zap.String("strategy", params.rankType),
+		zap.Int64("limit", params.limit))
+	return ret, nil
+}
@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/distance"
+)
+
+func TestParseRankParams(t *testing.T) {
+	kvs := func(pairs ...string) []*commonpb.KeyValuePair {
+		ret := make([]*commonpb.KeyValuePair, 0, len(pairs)/2)
+		for i := 0; i+1 < len(pairs); i += 2 {
+			ret = append(ret, &commonpb.KeyValuePair{Key: pairs[i], Value: pairs[i+1]})
+		}
+		return ret
+	}
+
+	tests := []struct {
+		description string
+		params      []*commonpb.KeyValuePair
+		expectErr   bool
+		rankType    string
+	}{
+		{"default rrf", kvs(LimitKey, "10"), false, RRFRankType},
+		{"rrf with k", kvs(LimitKey, "10", RankTypeKey, "RRF", RRFParamKey, "10"), false, RRFRankType},
+		{"weighted", kvs(LimitKey, "10", RankTypeKey, "weighted", WeightsKey, "[0.3, 0.7]"), false, WeightedRankType},
+		{"no limit", kvs(RankTypeKey, "rrf"), true, ""},
+		{"invalid limit", kvs(LimitKey, "-1"), true, ""},
+		{"invalid k", kvs(LimitKey, "10", RRFParamKey, "-1"), true, ""},
+		{"unknown strategy", kvs(LimitKey, "10", RankTypeKey, "unknown"), true, ""},
+		{"weighted without weights", kvs(LimitKey, "10", RankTypeKey, "weighted"), true, ""},
+		{"invalid weights", kvs(LimitKey, "10", RankTypeKey, "weighted", WeightsKey, "0.3,0.7"), true, ""},
+		{"weights mis-match", kvs(LimitKey, "10", RankTypeKey, "weighted", WeightsKey, "[1.0]"), true, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			params, err := parseRankParams(test.params, 2)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.rankType, params.rankType)
+			assert.Equal(t, int64(10), params.limit)
+		})
+	}
+}
+
+func TestFuseSearchResults(t *testing.T) {
+	genResult := func(ids []int64, scores []float32, topks []int64) *milvuspb.SearchResults {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Results: &schemapb.SearchResultData{
+				NumQueries: int64(len(topks)),
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}},
+				},
+				Scores: scores,
+				Topks:  topks,
+				FieldsData: []*schemapb.FieldData{
+					getFieldData("pk", 100, schemapb.DataType_Int64, ids, 1),
+				},
+			},
+		}
+	}
+
+	// nq = 2, query 0 hits [1, 2, 3] and [3, 4], query 1 hits [5] and [5, 6]
+	r1 := genResult([]int64{1, 2, 3, 5}, []float32{0.9, 0.8, 0.7, 0.5}, []int64{3, 1})
+	r2 := genResult([]int64{3, 4, 5, 6}, []float32{0.1, 0.2, 0.1, 0.4}, []int64{2, 2})
+	metricTypes := []string{distance.IP, distance.L2}
+
+	t.Run("rrf", func(t *testing.T) {
+		ret, err := fuseSearchResults(context.Background(), []*milvuspb.SearchResults{r1, r2}, metricTypes,
+			&rankParams{rankType: RRFRankType, k: defaultRRFParamK, limit: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 2}, ret.GetResults().GetTopks())
+		// 3 and 5 are hit by both sub searches and rank first
+		assert.Equal(t, int64(3), ret.GetResults().GetIds().GetIntId().GetData()[0])
+		assert.Equal(t, int64(5), ret.GetResults().GetIds().GetIntId().GetData()[2])
+		assert.Equal(t, ret.GetResults().GetIds().GetIntId().GetData(), ret.GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		ret, err := fuseSearchResults(context.Background(), []*milvuspb.SearchResults{r1, r2}, metricTypes,
+			&rankParams{rankType: WeightedRankType, weights: []float64{1, 0}, limit: 3})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 2}, ret.GetResults().GetTopks())
+		// only the first sub search takes effect
+		assert.Equal(t, []int64{1, 2, 3, 5}, ret.GetResults().GetIds().GetIntId().GetData()[:4])
+	})
+
+	t.Run("nq mis-match", func(t *testing.T) {
+		r3 := genResult([]int64{1}, []float32{0.1}, []int64{1})
+		_, err := fuseSearchResults(context.Background(), []*milvuspb.SearchResults{r1, r3}, metricTypes,
+			&rankParams{rankType: RRFRankType, k: defaultRRFParamK, limit: 2})
+		assert.Error(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := fuseSearchResults(context.Background(), nil, nil, &rankParams{})
+		assert.Error(t, err)
+	})
+}
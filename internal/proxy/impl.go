@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -48,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/errorutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
@@ -2489,6 +2491,105 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return qt.result, nil
 }
 
+// ListClients returns the clients connected to Proxy.
+func (node *Proxy) ListClients(ctx context.Context) ([]*connection.ClientInfo, error) {
+	if !node.checkHealthy() {
+		return nil, merr.WrapErrServiceNotReady(fmt.Sprintf("proxy %d is unhealthy", paramtable.GetNodeID()))
+	}
+	return connection.GetManager().List(), nil
+}
+
+// HybridSearch executes one search per vector field and fuses the results by rankParams.
+// All sub searches must target the same collection, the output fields of the first one are used for all.
+func (node *Proxy) HybridSearch(ctx context.Context, requests []*milvuspb.SearchRequest, rankParams []*commonpb.KeyValuePair) (*milvuspb.SearchResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	method := "HybridSearch"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-HybridSearch")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int("searchNum", len(requests)),
+		zap.Any("rank_params", rankParams))
+	log.Debug(rpcReceived(method))
+
+	failResp := func(err error) *milvuspb.SearchResults {
+		log.Warn("hybrid search failed", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+
+	if len(requests) == 0 {
+		return failResp(errors.New("hybrid search requires at least one sub search")), nil
+	}
+	if maxNum := Params.ProxyCfg.MaxVectorFieldNum.GetAsInt(); len(requests) > maxNum {
+		return failResp(fmt.Errorf("the number of sub searches (%d) exceeds the limit %d", len(requests), maxNum)), nil
+	}
+	params, err := parseRankParams(rankParams, len(requests))
+	if err != nil {
+		return failResp(err), nil
+	}
+
+	metricTypes := make([]string, len(requests))
+	for i, req := range requests {
+		if req.GetCollectionName() != requests[0].GetCollectionName() || req.GetDbName() != requests[0].GetDbName() {
+			return failResp(fmt.Errorf("sub searches of hybrid search must target the same collection, got %s and %s",
+				requests[0].GetCollectionName(), req.GetCollectionName())), nil
+		}
+		metricTypes[i], err = funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, req.GetSearchParams())
+		if err != nil {
+			return failResp(fmt.Errorf("%s not found in search_params of sub search %d", MetricTypeKey, i)), nil
+		}
+		req.OutputFields = requests[0].GetOutputFields()
+	}
+
+	results := make([]*milvuspb.SearchResults, len(requests))
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i := range requests {
+		i := i
+		group.Go(func() error {
+			result, err := node.Search(groupCtx, requests[i])
+			if err != nil {
+				return err
+			}
+			if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				return fmt.Errorf("sub search %d failed, reason: %s", i, result.GetStatus().GetReason())
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return failResp(err), nil
+	}
+
+	ret, err := fuseSearchResults(ctx, results, metricTypes, params)
+	if err != nil {
+		return failResp(err), nil
+	}
+
+	log.Debug(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ret, nil
+}
+
 // Flush notify data nodes to persist the data of collection.
 func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	resp := &milvuspb.FlushResponse{
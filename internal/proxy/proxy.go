@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -189,6 +190,9 @@ func (node *Proxy) Init() error {
 	accesslog.SetupAccseeLog(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
 	log.Debug("init access log for Proxy done")
 
+	accesslog.SetupAuditLog(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	log.Debug("init audit log for Proxy done")
+
 	err := node.initRateCollector()
 	if err != nil {
 		return err
@@ -376,6 +380,8 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	connection.GetManager().Start()
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
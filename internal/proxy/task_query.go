@@ -523,18 +523,25 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	if queryParams != nil && queryParams.limit != typeutil.Unlimited {
 		loopEnd = int(queryParams.limit)
 
-		if queryParams.offset > 0 {
-			for i := int64(0); i < queryParams.offset; i++ {
-				sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
-				if sel == -1 {
-					return ret, nil
-				}
-				cursors[sel]++
+		// each shard returns up to offset+limit rows, skip the first offset distinct pks here.
+		// duplicated pks across shards must not be counted, otherwise the page would drift.
+		for skipped := int64(0); skipped < queryParams.offset; {
+			sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
+			if sel == -1 {
+				return ret, nil
+			}
+			pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
+			if _, ok := idSet[pk]; !ok {
+				idSet[pk] = struct{}{}
+				skipped++
+			} else {
+				skipDupCnt++
 			}
+			cursors[sel]++
 		}
 	}
 
-	for j := 0; j < loopEnd; j++ {
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -544,6 +551,7 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		if _, ok := idSet[pk]; !ok {
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
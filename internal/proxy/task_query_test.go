@@ -84,9 +84,6 @@ func TestQueryTask_all(t *testing.T) {
 		testDoubleField:   schemapb.DataType_Double,
 		testFloatVecField: schemapb.DataType_FloatVector,
 	}
-	if enableMultipleVectorFields {
-		fieldName2Types[testBinaryVecField] = schemapb.DataType_BinaryVector
-	}
 
 	schema := constructCollectionSchemaByDataType(collectionName, fieldName2Types, testInt64Field, false)
 	marshaledSchema, err := proto.Marshal(schema)
@@ -475,6 +472,34 @@ func TestTaskQuery_functions(t *testing.T) {
 			assert.InDeltaSlice(t, FloatVector, result.FieldsData[1].GetVectors().GetFloatVector().Data, 10e-10)
 		})
 
+		t.Run("test offset with dupPK", func(t *testing.T) {
+			result1 := &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{0, 1},
+						},
+					},
+				},
+				FieldsData: fieldDataArray1,
+			}
+			result2 := &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{0, 1},
+						},
+					},
+				},
+				FieldsData: fieldDataArray2,
+			}
+
+			result, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{result1, result2}, &queryParams{limit: 1, offset: 1})
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{Int64Array[1]}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+			assert.InDeltaSlice(t, FloatVector[Dim:], result.FieldsData[1].GetVectors().GetFloatVector().Data, 10e-10)
+		})
+
 		t.Run("test nil results", func(t *testing.T) {
 			ret, err := reduceRetrieveResults(context.Background(), nil, nil)
 			assert.NoError(t, err)
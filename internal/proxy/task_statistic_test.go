@@ -73,9 +73,6 @@ func TestStatisticTask_all(t *testing.T) {
 		testDoubleField:   schemapb.DataType_Double,
 		testFloatVecField: schemapb.DataType_FloatVector,
 	}
-	if enableMultipleVectorFields {
-		fieldName2Types[testBinaryVecField] = schemapb.DataType_BinaryVector
-	}
 
 	schema := constructCollectionSchemaByDataType(collectionName, fieldName2Types, testInt64Field, false)
 	marshaledSchema, err := proto.Marshal(schema)
@@ -97,6 +97,7 @@ func constructCollectionSchema(
 		IndexParams: nil,
 		AutoID:      false,
 	}
+
 	return &schemapb.CollectionSchema{
 		Name:        collectionName,
 		Description: "",
@@ -218,39 +219,6 @@ func constructCollectionSchemaWithAllType(
 		IndexParams: nil,
 		AutoID:      false,
 	}
-	bVec := &schemapb.FieldSchema{
-		FieldID:      0,
-		Name:         binaryVecField,
-		IsPrimaryKey: false,
-		Description:  "",
-		DataType:     schemapb.DataType_BinaryVector,
-		TypeParams: []*commonpb.KeyValuePair{
-			{
-				Key:   "dim",
-				Value: strconv.Itoa(dim),
-			},
-		},
-		IndexParams: nil,
-		AutoID:      false,
-	}
-
-	if enableMultipleVectorFields {
-		return &schemapb.CollectionSchema{
-			Name:        collectionName,
-			Description: "",
-			AutoID:      false,
-			Fields: []*schemapb.FieldSchema{
-				b,
-				i32,
-				i64,
-				f,
-				d,
-				fVec,
-				bVec,
-			},
-		}
-	}
-
 	return &schemapb.CollectionSchema{
 		Name:        collectionName,
 		Description: "",
@@ -262,7 +230,6 @@ func constructCollectionSchemaWithAllType(
 			f,
 			d,
 			fVec,
-			// bVec,
 		},
 	}
 }
@@ -742,11 +709,12 @@ func TestCreateCollectionTask(t *testing.T) {
 		assert.NoError(t, err)
 		task.CreateCollectionRequest.Schema = twoVecFieldsSchema
 		err = task.PreExecute(ctx)
-		if enableMultipleVectorFields {
-			assert.NoError(t, err)
-		} else {
-			assert.Error(t, err)
-		}
+		assert.NoError(t, err)
+
+		paramtable.Get().Save(Params.ProxyCfg.MaxVectorFieldNum.Key, "1")
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		paramtable.Get().Reset(Params.ProxyCfg.MaxVectorFieldNum.Key)
 	})
 }
 
@@ -1281,9 +1249,6 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 		testFloatField:    schemapb.DataType_Float,
 		testDoubleField:   schemapb.DataType_Double,
 		testFloatVecField: schemapb.DataType_FloatVector}
-	if enableMultipleVectorFields {
-		fieldName2Types[testBinaryVecField] = schemapb.DataType_BinaryVector
-	}
 	nb := 10
 
 	t.Run("create collection", func(t *testing.T) {
@@ -1535,9 +1500,6 @@ func TestTask_VarCharPrimaryKey(t *testing.T) {
 		testDoubleField:   schemapb.DataType_Double,
 		testVarCharField:  schemapb.DataType_VarChar,
 		testFloatVecField: schemapb.DataType_FloatVector}
-	if enableMultipleVectorFields {
-		fieldName2Types[testBinaryVecField] = schemapb.DataType_BinaryVector
-	}
 	nb := 10
 
 	t.Run("create collection", func(t *testing.T) {
@@ -47,9 +47,6 @@ const (
 	strongTS  = 0
 	boundedTS = 2
 
-	// enableMultipleVectorFields indicates whether to enable multiple vector fields.
-	enableMultipleVectorFields = false
-
 	// maximum length of variable-length strings
 	maxVarCharLengthKey = "max_length"
 
@@ -476,27 +473,27 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
-// validateMultipleVectorFields check if schema has multiple vector fields.
+// validateMultipleVectorFields check if the number of vector fields in schema exceeds the limit.
 func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
-	vecExist := false
-	var vecName string
+	maxVectorFieldNum := Params.ProxyCfg.MaxVectorFieldNum.GetAsInt()
+	vecNames := make([]string, 0)
 
 	for i := range schema.Fields {
 		name := schema.Fields[i].Name
 		dType := schema.Fields[i].DataType
-		isVec := dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector
-		if isVec && vecExist && !enableMultipleVectorFields {
-			return fmt.Errorf(
-				"multiple vector fields is not supported, fields name: %s, %s",
-				vecName,
-				name,
-			)
-		} else if isVec {
-			vecExist = true
-			vecName = name
+		if dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector {
+			vecNames = append(vecNames, name)
 		}
 	}
 
+	if len(vecNames) > maxVectorFieldNum {
+		return fmt.Errorf(
+			"maximum vector field's number should be limited to %d, fields name: %s",
+			maxVectorFieldNum,
+			strings.Join(vecNames, ", "),
+		)
+	}
+
 	return nil
 }
 
@@ -592,11 +592,12 @@ func TestValidateMultipleVectorFields(t *testing.T) {
 			},
 		},
 	}
-	if enableMultipleVectorFields {
-		assert.NoError(t, validateMultipleVectorFields(schema3))
-	} else {
-		assert.Error(t, validateMultipleVectorFields(schema3))
-	}
+	assert.NoError(t, validateMultipleVectorFields(schema3))
+
+	// case4, vector fields exceed limit
+	paramtable.Get().Save(Params.ProxyCfg.MaxVectorFieldNum.Key, "1")
+	defer paramtable.Get().Reset(Params.ProxyCfg.MaxVectorFieldNum.Key)
+	assert.Error(t, validateMultipleVectorFields(schema3))
 }
 
 func TestFillFieldIDBySchema(t *testing.T) {
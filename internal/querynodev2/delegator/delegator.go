@@ -276,6 +276,12 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 		growing = []SegmentEntry{}
 	}
 
+	// point lookup by primary keys, skip the segments which bloom filters reject all the pks
+	if pks, ok := getPrimaryKeysFromPlan(req.GetReq().GetSerializedExprPlan()); ok {
+		sealed = filterSnapshotByPks(sd.pkOracle, sealed, pks)
+		growing = filterSegmentsByPks(sd.pkOracle, growing, pks, commonpb.SegmentState_Growing)
+	}
+
 	log.Info("query segments...",
 		zap.Int("sealedNum", len(sealed)),
 		zap.Int("growingNum", len(growing)),
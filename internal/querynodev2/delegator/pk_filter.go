@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// getPrimaryKeysFromPlan returns the primary keys if the predicate of serialized plan is `pk in [...]`,
+// which could be used to skip the segments not containing any of them.
+func getPrimaryKeysFromPlan(serializedPlan []byte) ([]storage.PrimaryKey, bool) {
+	if len(serializedPlan) == 0 {
+		return nil, false
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, false
+	}
+
+	var expr *planpb.Expr
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_Predicates:
+		expr = node.Predicates
+	case *planpb.PlanNode_Query:
+		expr = node.Query.GetPredicates()
+	default:
+		return nil, false
+	}
+
+	term := expr.GetTermExpr()
+	if term == nil || !term.GetColumnInfo().GetIsPrimaryKey() {
+		return nil, false
+	}
+
+	pks := make([]storage.PrimaryKey, 0, len(term.GetValues()))
+	for _, value := range term.GetValues() {
+		switch term.GetColumnInfo().GetDataType() {
+		case schemapb.DataType_Int64:
+			pks = append(pks, storage.NewInt64PrimaryKey(value.GetInt64Val()))
+		case schemapb.DataType_VarChar:
+			pks = append(pks, storage.NewVarCharPrimaryKey(value.GetStringVal()))
+		default:
+			return nil, false
+		}
+	}
+	return pks, true
+}
+
+// filterSegmentsByPks keeps the segments which may contain any of the primary keys,
+// segments not tracked by pkOracle are always kept.
+func filterSegmentsByPks(oracle pkoracle.PkOracle, entries []SegmentEntry, pks []storage.PrimaryKey, state commonpb.SegmentState) []SegmentEntry {
+	hits := typeutil.NewSet[int64]()
+	for _, pk := range pks {
+		segmentIDs, _ := oracle.Get(pk, pkoracle.WithSegmentType(state))
+		hits.Insert(segmentIDs...)
+	}
+
+	return lo.Filter(entries, func(entry SegmentEntry, _ int) bool {
+		if hits.Contain(entry.SegmentID) {
+			return true
+		}
+		return !oracle.Exists(pkoracle.NewCandidateKey(entry.SegmentID, entry.PartitionID, state), entry.NodeID)
+	})
+}
+
+// filterSnapshotByPks applies filterSegmentsByPks on each item of the sealed snapshot.
+func filterSnapshotByPks(oracle pkoracle.PkOracle, items []SnapshotItem, pks []storage.PrimaryKey) []SnapshotItem {
+	return lo.Map(items, func(item SnapshotItem, _ int) SnapshotItem {
+		return SnapshotItem{
+			NodeID:   item.NodeID,
+			Segments: filterSegmentsByPks(oracle, item.Segments, pks, commonpb.SegmentState_Sealed),
+		}
+	})
+}
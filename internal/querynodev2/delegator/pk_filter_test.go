@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+type PkFilterSuite struct {
+	suite.Suite
+}
+
+func (s *PkFilterSuite) genTermPlan(isPk bool, values ...int64) []byte {
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{
+			Predicates: &planpb.Expr{
+				Expr: &planpb.Expr_TermExpr{
+					TermExpr: &planpb.TermExpr{
+						ColumnInfo: &planpb.ColumnInfo{
+							FieldId:      100,
+							DataType:     schemapb.DataType_Int64,
+							IsPrimaryKey: isPk,
+						},
+						Values: lo.Map(values, func(v int64, _ int) *planpb.GenericValue {
+							return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+						}),
+					},
+				},
+			},
+		},
+	}
+	bs, err := proto.Marshal(plan)
+	s.Require().NoError(err)
+	return bs
+}
+
+func (s *PkFilterSuite) TestGetPrimaryKeysFromPlan() {
+	pks, ok := getPrimaryKeysFromPlan(s.genTermPlan(true, 1, 2))
+	s.True(ok)
+	s.Equal(2, len(pks))
+	s.True(pks[0].EQ(storage.NewInt64PrimaryKey(1)))
+
+	_, ok = getPrimaryKeysFromPlan(s.genTermPlan(false, 1, 2))
+	s.False(ok)
+
+	_, ok = getPrimaryKeysFromPlan(nil)
+	s.False(ok)
+
+	_, ok = getPrimaryKeysFromPlan([]byte("invalid plan"))
+	s.False(ok)
+}
+
+func (s *PkFilterSuite) TestFilterSegmentsByPks() {
+	oracle := pkoracle.NewPkOracle()
+	bfs1 := pkoracle.NewBloomFilterSet(1, 10, commonpb.SegmentState_Sealed)
+	bfs1.UpdateBloomFilter([]storage.PrimaryKey{storage.NewInt64PrimaryKey(1)})
+	bfs2 := pkoracle.NewBloomFilterSet(2, 10, commonpb.SegmentState_Sealed)
+	bfs2.UpdateBloomFilter([]storage.PrimaryKey{storage.NewInt64PrimaryKey(2)})
+	oracle.Register(bfs1, 1)
+	oracle.Register(bfs2, 1)
+
+	items := []SnapshotItem{
+		{
+			NodeID: 1,
+			Segments: []SegmentEntry{
+				{NodeID: 1, SegmentID: 1, PartitionID: 10},
+				{NodeID: 1, SegmentID: 2, PartitionID: 10},
+				// not tracked by pk oracle, shall be kept
+				{NodeID: 1, SegmentID: 3, PartitionID: 10},
+			},
+		},
+	}
+
+	result := filterSnapshotByPks(oracle, items, []storage.PrimaryKey{storage.NewInt64PrimaryKey(1)})
+	s.Require().Len(result, 1)
+	s.ElementsMatch([]int64{1, 3}, lo.Map(result[0].Segments, func(entry SegmentEntry, _ int) int64 {
+		return entry.SegmentID
+	}))
+
+	growing := filterSegmentsByPks(oracle, items[0].Segments, []storage.PrimaryKey{storage.NewInt64PrimaryKey(1)}, commonpb.SegmentState_Growing)
+	// no growing candidate registered, all kept
+	s.Len(growing, 3)
+}
+
+func TestPkFilter(t *testing.T) {
+	suite.Run(t, new(PkFilterSuite))
+}
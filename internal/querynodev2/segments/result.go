@@ -246,7 +246,8 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idTsMap := make(map[interface{}]uint64)
 	cursors := make([]int64, len(validRetrieveResults))
-	for j := 0; j < loopEnd; j++ {
+	// only distinct pks count towards limit, so the proxy always receives offset+limit rows per shard
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -258,6 +259,7 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idTsMap[pk] = ts
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
@@ -327,7 +329,7 @@ func MergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
-	for j := 0; j < loopEnd; j++ {
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -338,6 +340,7 @@ func MergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
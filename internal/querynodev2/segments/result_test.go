@@ -86,6 +86,36 @@ func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 		suite.InDeltaSlice(FloatVector, result.FieldsData[1].GetVectors().GetFloatVector().Data, 10e-10)
 	})
 
+	suite.Run("test limit with dupPK", func() {
+		result1 := &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{0, 1},
+					},
+				},
+			},
+			Offset:     []int64{0, 1},
+			FieldsData: fieldDataArray1,
+		}
+		result2 := &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{0, 1},
+					},
+				},
+			},
+			Offset:     []int64{0, 1},
+			FieldsData: fieldDataArray2,
+		}
+
+		result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{result1, result2}, 2)
+		suite.NoError(err)
+		suite.Equal([]int64{0, 1}, result.GetIds().GetIntId().GetData())
+		suite.Equal(Int64Array, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+	})
+
 	suite.Run("test nil results", func() {
 		ret, err := MergeSegcoreRetrieveResults(context.Background(), nil, typeutil.Unlimited)
 		suite.NoError(err)
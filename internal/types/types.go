@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
 )
 
 // TimeTickProvider is the interface all services implement
@@ -1142,6 +1143,17 @@ type ProxyComponent interface {
 	// error is always nil
 	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
 
+	// HybridSearch notifies Proxy to search on multiple vector fields and fuse the results
+	//
+	// ctx is the context to control request deadline and cancellation
+	// requests contains one search request per vector field, all of them must target the same collection
+	// rankParams contains the fusion strategy(rrf or weighted), its params and the limit of fused results
+	//
+	// The `Status` in response struct `SearchResults` indicates if this operation is processed successfully or fail cause;
+	// the `Results` in `SearchResults` return fused search results.
+	// error is always nil
+	HybridSearch(ctx context.Context, requests []*milvuspb.SearchRequest, rankParams []*commonpb.KeyValuePair) (*milvuspb.SearchResults, error)
+
 	// Flush notifies Proxy to flush buffer into storage
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1325,6 +1337,9 @@ type ProxyComponent interface {
 	TransferReplica(ctx context.Context, req *milvuspb.TransferReplicaRequest) (*commonpb.Status, error)
 	ListResourceGroups(ctx context.Context, req *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)
 	DescribeResourceGroup(ctx context.Context, req *milvuspb.DescribeResourceGroupRequest) (*milvuspb.DescribeResourceGroupResponse, error)
+
+	// ListClients returns the clients connected to Proxy, with their user, sdk version and last active time
+	ListClients(ctx context.Context) ([]*connection.ClientInfo, error)
 }
 
 // QueryNode is the interface `querynode` package implements
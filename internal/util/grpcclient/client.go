@@ -75,6 +75,8 @@ type ClientBase[T interface {
 	ClientMaxRecvSize      int
 	CompressionEnabled     bool
 	RetryServiceNameConfig string
+	// InternalTLSConfig enables mutual TLS to internal endpoints if not nil
+	InternalTLSConfig *tls.Config
 
 	DialTimeout      time.Duration
 	KeepAliveTime    time.Duration
@@ -214,11 +216,14 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 			grpc.WithPerRPCCredentials(&Token{Value: crypto.Base64Encode(util.MemberCredID)}),
 		)
 	} else {
+		transportOption := grpc.WithInsecure()
+		if c.InternalTLSConfig != nil {
+			transportOption = grpc.WithTransportCredentials(credentials.NewTLS(c.InternalTLSConfig))
+		}
 		conn, err = grpc.DialContext(
 			dialContext,
 			addr,
-			grpc.WithInsecure(),
-			//grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
+			transportOption,
 			grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(c.ClientMaxRecvSize),
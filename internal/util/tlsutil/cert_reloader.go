@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// CertReloader holds the certificate and CA pool loaded from files,
+// and reloads them when the files are modified, so that certificates could be rotated without restart.
+type CertReloader struct {
+	certPath string
+	keyPath  string
+	// caPath is optional, the system CA pool is used if empty
+	caPath string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+	modTimes map[string]time.Time
+
+	interval  time.Duration
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewCertReloader loads the certificate files, and checks them for modification every interval,
+// reloading is disabled if interval is not positive.
+func NewCertReloader(certPath, keyPath, caPath string, interval time.Duration) (*CertReloader, error) {
+	r := &CertReloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		caPath:   caPath,
+		modTimes: make(map[string]time.Time),
+		interval: interval,
+		closeCh:  make(chan struct{}),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	if interval > 0 {
+		r.wg.Add(1)
+		go r.watch()
+	}
+	return r, nil
+}
+
+func (r *CertReloader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, path := range r.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to stat %s", path)
+		}
+		modTimes[path] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to load x509 key pair")
+	}
+
+	var caPool *x509.CertPool
+	if r.caPath != "" {
+		rootBuf, err := os.ReadFile(r.caPath)
+		if err != nil {
+			return errors.Wrap(err, "failed to read ca pem")
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(rootBuf) {
+			return fmt.Errorf("failed to append ca %s to cert pool", r.caPath)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.caPool = caPool
+	r.modTimes = modTimes
+	return nil
+}
+
+func (r *CertReloader) paths() []string {
+	paths := []string{r.certPath, r.keyPath}
+	if r.caPath != "" {
+		paths = append(paths, r.caPath)
+	}
+	return paths
+}
+
+// modified returns whether any of the files is modified since last load
+func (r *CertReloader) modified() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, path := range r.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			// the file may be in the middle of replacement, check it next round
+			return false
+		}
+		if !info.ModTime().Equal(r.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CertReloader) watch() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.closeCh:
+			return
+		case <-ticker.C:
+			if !r.modified() {
+				continue
+			}
+			if err := r.load(); err != nil {
+				log.Warn("failed to reload certificates, keep using the old ones",
+					zap.String("certPath", r.certPath), zap.Error(err))
+				continue
+			}
+			log.Info("certificates reloaded", zap.String("certPath", r.certPath))
+		}
+	}
+}
+
+// Close stops watching the files.
+func (r *CertReloader) Close() {
+	r.closeOnce.Do(func() {
+		close(r.closeCh)
+		r.wg.Wait()
+	})
+}
+
+// GetCertificate returns the current certificate, implements tls.Config.GetCertificate.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// GetClientCertificate returns the current certificate, implements tls.Config.GetClientCertificate.
+func (r *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// CertPool returns the current CA pool, nil means the system CA pool.
+func (r *CertReloader) CertPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.caPool
+}
+
+// ServerConfig returns the tls config for server, each handshake uses the current certificate and CA pool.
+func (r *CertReloader) ServerConfig(clientAuth tls.ClientAuthType, minVersion uint16) *tls.Config {
+	return &tls.Config{
+		MinVersion: minVersion,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				MinVersion:     minVersion,
+				ClientAuth:     clientAuth,
+				ClientCAs:      r.CertPool(),
+				GetCertificate: r.GetCertificate,
+			}, nil
+		},
+	}
+}
+
+// ClientConfig returns the tls config for client, each handshake uses the current certificate and CA pool.
+// The server certificate is verified against serverName if not empty, otherwise the dialed host.
+func (r *CertReloader) ClientConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		MinVersion:           tls.VersionTLS13,
+		GetClientCertificate: r.GetClientCertificate,
+		// RootCAs of tls.Config could not be replaced after created,
+		// so the built-in verification is skipped and VerifyConnection verifies with the current CA pool.
+		// #nosec G402
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			return r.verifyServer(cs, serverName)
+		},
+	}
+}
+
+func (r *CertReloader) verifyServer(cs tls.ConnectionState, serverName string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("no certificate provided by server")
+	}
+	if serverName == "" {
+		serverName = cs.ServerName
+	}
+	opts := x509.VerifyOptions{
+		Roots:         r.CertPool(),
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CertReloaderSuite struct {
+	suite.Suite
+
+	dir      string
+	caCert   *x509.Certificate
+	caKey    *ecdsa.PrivateKey
+	certPath string
+	keyPath  string
+	caPath   string
+}
+
+func (s *CertReloaderSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.certPath = path.Join(s.dir, "server.pem")
+	s.keyPath = path.Join(s.dir, "server.key")
+	s.caPath = path.Join(s.dir, "ca.pem")
+
+	var err error
+	s.caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &s.caKey.PublicKey, s.caKey)
+	s.Require().NoError(err)
+	s.caCert, err = x509.ParseCertificate(der)
+	s.Require().NoError(err)
+	s.writePem(s.caPath, "CERTIFICATE", der)
+
+	s.issue(2)
+}
+
+func (s *CertReloaderSuite) writePem(file string, blockType string, der []byte) {
+	s.Require().NoError(os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+}
+
+// issue writes a certificate signed by the ca with the serial number
+func (s *CertReloaderSuite) issue(serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, &key.PublicKey, s.caKey)
+	s.Require().NoError(err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	s.Require().NoError(err)
+	s.writePem(s.certPath, "CERTIFICATE", der)
+	s.writePem(s.keyPath, "EC PRIVATE KEY", keyDer)
+
+	// make sure the modification is observed even if the file system has coarse timestamp
+	modTime := time.Now().Add(time.Duration(serial) * time.Second)
+	s.Require().NoError(os.Chtimes(s.certPath, modTime, modTime))
+	s.Require().NoError(os.Chtimes(s.keyPath, modTime, modTime))
+}
+
+func (s *CertReloaderSuite) serialOf(reloader *CertReloader) int64 {
+	cert, err := reloader.GetCertificate(nil)
+	s.Require().NoError(err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	s.Require().NoError(err)
+	return leaf.SerialNumber.Int64()
+}
+
+func (s *CertReloaderSuite) TestReload() {
+	reloader, err := NewCertReloader(s.certPath, s.keyPath, s.caPath, 10*time.Millisecond)
+	s.Require().NoError(err)
+	defer reloader.Close()
+	s.Equal(int64(2), s.serialOf(reloader))
+
+	s.issue(3)
+	s.Eventually(func() bool {
+		return s.serialOf(reloader) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// broken files are ignored, the old certificate is kept
+	s.Require().NoError(os.WriteFile(s.keyPath, []byte("broken"), 0600))
+	time.Sleep(50 * time.Millisecond)
+	s.Equal(int64(3), s.serialOf(reloader))
+}
+
+func (s *CertReloaderSuite) TestLoadFailed() {
+	_, err := NewCertReloader(path.Join(s.dir, "not-exist.pem"), s.keyPath, s.caPath, 0)
+	s.Error(err)
+
+	_, err = NewCertReloader(s.certPath, s.keyPath, s.keyPath, 0)
+	s.Error(err)
+}
+
+func (s *CertReloaderSuite) TestMutualHandshake() {
+	reloader, err := NewCertReloader(s.certPath, s.keyPath, s.caPath, 0)
+	s.Require().NoError(err)
+	defer reloader.Close()
+
+	handshake := func(serverName string) error {
+		serverConn, clientConn := net.Pipe()
+		defer serverConn.Close()
+		defer clientConn.Close()
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- tls.Server(serverConn, reloader.ServerConfig(tls.RequireAndVerifyClientCert, tls.VersionTLS13)).Handshake()
+		}()
+		clientErr := tls.Client(clientConn, reloader.ClientConfig(serverName)).Handshake()
+		if clientErr != nil {
+			clientConn.Close()
+			<-errCh
+			return clientErr
+		}
+		return <-errCh
+	}
+
+	s.NoError(handshake("localhost"))
+	s.Error(handshake("other-host"))
+}
+
+func TestCertReloader(t *testing.T) {
+	suite.Run(t, new(CertReloaderSuite))
+}
@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var (
+	reloaders   = make(map[string]*CertReloader)
+	reloadersMu sync.Mutex
+)
+
+// GetCertReloader returns the CertReloader of the files, which is shared by servers and clients in the process.
+func GetCertReloader(certPath, keyPath, caPath string, interval time.Duration) (*CertReloader, error) {
+	reloadersMu.Lock()
+	defer reloadersMu.Unlock()
+
+	key := certPath + "|" + keyPath + "|" + caPath
+	if reloader, ok := reloaders[key]; ok {
+		return reloader, nil
+	}
+	reloader, err := NewCertReloader(certPath, keyPath, caPath, interval)
+	if err != nil {
+		return nil, err
+	}
+	reloaders[key] = reloader
+	return reloader, nil
+}
+
+// InternalServerOptions returns the grpc server options for internal endpoints,
+// which enable mutual TLS if common.security.internalTlsEnabled is true.
+func InternalServerOptions(params *paramtable.GrpcServerConfig) ([]grpc.ServerOption, error) {
+	if !params.InternalTLSEnabled.GetAsBool() {
+		return nil, nil
+	}
+	reloader, err := GetCertReloader(params.ServerPemPath.GetValue(), params.ServerKeyPath.GetValue(),
+		params.CaPemPath.GetValue(), params.TLSReloadInterval.GetAsDuration(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(reloader.ServerConfig(tls.RequireAndVerifyClientCert, tls.VersionTLS13))),
+	}, nil
+}
+
+// InternalClientConfig returns the tls config for clients of internal endpoints,
+// nil if common.security.internalTlsEnabled is false.
+func InternalClientConfig(params *paramtable.GrpcClientConfig) (*tls.Config, error) {
+	if !params.InternalTLSEnabled.GetAsBool() {
+		return nil, nil
+	}
+	reloader, err := GetCertReloader(params.ServerPemPath.GetValue(), params.ServerKeyPath.GetValue(),
+		params.CaPemPath.GetValue(), params.TLSReloadInterval.GetAsDuration(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	return reloader.ClientConfig(params.InternalServerName.GetValue()), nil
+}
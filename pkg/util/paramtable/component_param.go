@@ -860,6 +860,13 @@ type AccessLogConfig struct {
 	RemoteMaxTime ParamItem `refreshable:"false"`
 }
 
+// AuditLogConfig shares the rotation and minio related configs with AccessLogConfig
+type AuditLogConfig struct {
+	AccessLogConfig
+	// Categories is the comma separated request categories to audit, e.g. DDL,DCL,DML,DQL
+	Categories ParamItem `refreshable:"false"`
+}
+
 type proxyConfig struct {
 	// Alias  string
 	SoPath ParamItem `refreshable:"false"`
@@ -871,6 +878,7 @@ type proxyConfig struct {
 	MinPasswordLength        ParamItem `refreshable:"true"`
 	MaxPasswordLength        ParamItem `refreshable:"true"`
 	MaxFieldNum              ParamItem `refreshable:"true"`
+	MaxVectorFieldNum        ParamItem `refreshable:"true"`
 	MaxShardNum              ParamItem `refreshable:"true"`
 	MaxDimension             ParamItem `refreshable:"true"`
 	GinLogging               ParamItem `refreshable:"false"`
@@ -878,7 +886,13 @@ type proxyConfig struct {
 	MaxRoleNum               ParamItem `refreshable:"true"`
 	MaxTaskNum               ParamItem `refreshable:"false"`
 	AccessLog                AccessLogConfig
+	AuditLog                 AuditLogConfig
 	ShardLeaderCacheInterval ParamItem `refreshable:"false"`
+
+	ResponseCompressionEnabled ParamItem `refreshable:"false"`
+
+	ConnectionIdleTimeout   ParamItem `refreshable:"true"`
+	ConnectionCheckInterval ParamItem `refreshable:"false"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -947,6 +961,44 @@ So adjust at your risk!`,
 	}
 	p.MaxFieldNum.Init(base.mgr)
 
+	p.MaxVectorFieldNum = ParamItem{
+		Key:          "proxy.maxVectorFieldNum",
+		Version:      "2.3.0",
+		DefaultValue: "4",
+		PanicIfEmpty: true,
+		Doc:          "Maximum number of vector fields in a collection",
+		Export:       true,
+	}
+	p.MaxVectorFieldNum.Init(base.mgr)
+
+	p.ResponseCompressionEnabled = ParamItem{
+		Key:          "proxy.grpc.responseCompressionEnabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc: `Whether to compress all the responses of proxy grpc service with gzip.
+If false, a response is compressed only when the client compresses its request, using the same algorithm.`,
+		Export: true,
+	}
+	p.ResponseCompressionEnabled.Init(base.mgr)
+
+	p.ConnectionIdleTimeout = ParamItem{
+		Key:          "proxy.connection.idleTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "3600",
+		Doc:          "clients without any request longer than the timeout are expired and their connections closed, in seconds",
+		Export:       true,
+	}
+	p.ConnectionIdleTimeout.Init(base.mgr)
+
+	p.ConnectionCheckInterval = ParamItem{
+		Key:          "proxy.connection.checkInterval",
+		Version:      "2.3.0",
+		DefaultValue: "120",
+		Doc:          "interval to check the idle clients, in seconds",
+		Export:       true,
+	}
+	p.ConnectionCheckInterval.Init(base.mgr)
+
 	p.MaxShardNum = ParamItem{
 		Key:          "proxy.maxShardNum",
 		DefaultValue: "64",
@@ -1081,6 +1133,88 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.RemoteMaxTime.Init(base.mgr)
 
+	p.AuditLog.Enable = ParamItem{
+		Key:          "proxy.auditLog.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "if use audit log, which records who executed which request with its result and latency",
+		Export:       true,
+	}
+	p.AuditLog.Enable.Init(base.mgr)
+
+	p.AuditLog.Categories = ParamItem{
+		Key:          "proxy.auditLog.categories",
+		Version:      "2.3.0",
+		DefaultValue: "DDL,DCL,DML,DQL",
+		Doc:          "comma separated request categories to audit, options: DDL, DCL, DML, DQL",
+		Export:       true,
+	}
+	p.AuditLog.Categories.Init(base.mgr)
+
+	p.AuditLog.MinioEnable = ParamItem{
+		Key:          "proxy.auditLog.minioEnable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "if upload sealed audit log file to minio",
+	}
+	p.AuditLog.MinioEnable.Init(base.mgr)
+
+	p.AuditLog.LocalPath = ParamItem{
+		Key:     "proxy.auditLog.localPath",
+		Version: "2.3.0",
+		Export:  true,
+	}
+	p.AuditLog.LocalPath.Init(base.mgr)
+
+	p.AuditLog.Filename = ParamItem{
+		Key:          "proxy.auditLog.filename",
+		Version:      "2.3.0",
+		DefaultValue: "milvus_audit_log.log",
+		Doc:          "Log filename, leave empty to write audit log to stdout.",
+		Export:       true,
+	}
+	p.AuditLog.Filename.Init(base.mgr)
+
+	p.AuditLog.MaxSize = ParamItem{
+		Key:          "proxy.auditLog.maxSize",
+		Version:      "2.3.0",
+		DefaultValue: "64",
+		Doc:          "Max size for a single file, in MB.",
+	}
+	p.AuditLog.MaxSize.Init(base.mgr)
+
+	p.AuditLog.MaxBackups = ParamItem{
+		Key:          "proxy.auditLog.maxBackups",
+		Version:      "2.3.0",
+		DefaultValue: "8",
+		Doc:          "Maximum number of old log files to retain.",
+	}
+	p.AuditLog.MaxBackups.Init(base.mgr)
+
+	p.AuditLog.RotatedTime = ParamItem{
+		Key:          "proxy.auditLog.rotatedTime",
+		Version:      "2.3.0",
+		DefaultValue: "3600",
+		Doc:          "Max time for single audit log file in seconds",
+	}
+	p.AuditLog.RotatedTime.Init(base.mgr)
+
+	p.AuditLog.RemotePath = ParamItem{
+		Key:          "proxy.auditLog.remotePath",
+		Version:      "2.3.0",
+		DefaultValue: "audit_log/",
+		Doc:          "File path in minIO",
+	}
+	p.AuditLog.RemotePath.Init(base.mgr)
+
+	p.AuditLog.RemoteMaxTime = ParamItem{
+		Key:          "proxy.auditLog.remoteMaxTime",
+		Version:      "2.3.0",
+		DefaultValue: "168",
+		Doc:          "Max time for log file in minIO, in hours",
+	}
+	p.AuditLog.RemoteMaxTime.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
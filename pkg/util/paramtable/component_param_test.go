@@ -160,6 +160,11 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("MaxFieldNum: %d", Params.MaxFieldNum.GetAsInt64())
 
+		assert.Equal(t, 4, Params.MaxVectorFieldNum.GetAsInt())
+		assert.False(t, Params.ResponseCompressionEnabled.GetAsBool())
+		assert.Equal(t, 3600, Params.ConnectionIdleTimeout.GetAsInt())
+		assert.Equal(t, 120, Params.ConnectionCheckInterval.GetAsInt())
+
 		t.Logf("MaxShardNum: %d", Params.MaxShardNum.GetAsInt64())
 
 		t.Logf("MaxDimension: %d", Params.MaxDimension.GetAsInt64())
@@ -174,6 +179,10 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("AccessLog.MaxDays: %d", Params.AccessLog.RotatedTime.GetAsInt64())
 
+		assert.False(t, Params.AuditLog.Enable.GetAsBool())
+		assert.Equal(t, "DDL,DCL,DML,DQL", Params.AuditLog.Categories.GetValue())
+		assert.Equal(t, "milvus_audit_log.log", Params.AuditLog.Filename.GetValue())
+
 		t.Logf("ShardLeaderCacheInterval: %d", Params.ShardLeaderCacheInterval.GetAsInt64())
 	})
 
@@ -65,6 +65,10 @@ type grpcConfig struct {
 	ServerPemPath ParamItem `refreshable:"false"`
 	ServerKeyPath ParamItem `refreshable:"false"`
 	CaPemPath     ParamItem `refreshable:"false"`
+
+	InternalTLSEnabled ParamItem `refreshable:"false"`
+	InternalServerName ParamItem `refreshable:"false"`
+	TLSReloadInterval  ParamItem `refreshable:"false"`
 }
 
 func (p *grpcConfig) init(domain string, base *BaseTable) {
@@ -114,6 +118,32 @@ func (p *grpcConfig) init(domain string, base *BaseTable) {
 		Export:  true,
 	}
 	p.CaPemPath.Init(base.mgr)
+
+	p.InternalTLSEnabled = ParamItem{
+		Key:          "common.security.internalTlsEnabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "whether to enable mutual TLS between components, with the certificates configured in tls section",
+		Export:       true,
+	}
+	p.InternalTLSEnabled.Init(base.mgr)
+
+	p.InternalServerName = ParamItem{
+		Key:     "tls.internalServerName",
+		Version: "2.3.0",
+		Doc:     "the name to verify the certificates of internal servers, the dialed host is used if empty",
+		Export:  true,
+	}
+	p.InternalServerName.Init(base.mgr)
+
+	p.TLSReloadInterval = ParamItem{
+		Key:          "tls.reloadInterval",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+		Doc:          "interval in seconds to check the certificate files for modification and reload them, non-positive to disable",
+		Export:       true,
+	}
+	p.TLSReloadInterval.Init(base.mgr)
 }
 
 // GetAddress return grpc address
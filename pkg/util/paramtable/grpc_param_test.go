@@ -158,4 +158,12 @@ func TestGrpcClientParams(t *testing.T) {
 	assert.Equal(t, clientConfig.ServerPemPath.GetValue(), "/pem")
 	assert.Equal(t, clientConfig.ServerKeyPath.GetValue(), "/key")
 	assert.Equal(t, clientConfig.CaPemPath.GetValue(), "/ca")
+
+	assert.False(t, clientConfig.InternalTLSEnabled.GetAsBool())
+	assert.Equal(t, "", clientConfig.InternalServerName.GetValue())
+	assert.Equal(t, 60, clientConfig.TLSReloadInterval.GetAsInt())
+	base.Save("common.security.internalTlsEnabled", "true")
+	base.Save("tls.internalServerName", "milvus")
+	assert.True(t, clientConfig.InternalTLSEnabled.GetAsBool())
+	assert.Equal(t, "milvus", clientConfig.InternalServerName.GetValue())
 }